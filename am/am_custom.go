@@ -0,0 +1,16 @@
+package am
+
+import (
+	"github.com/kumose-go/xgit/types"
+)
+
+// Mbox The list of mailbox files to read patches from. If you do not supply this argument, the command reads from the standard input.
+// If you supply directories, they will be treated as Maildirs.
+// [(<mbox> | <Maildir>)...]
+func Mbox(mboxes ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, mbox := range mboxes {
+			g.AddOptions(mbox)
+		}
+	}
+}
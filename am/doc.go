@@ -0,0 +1,28 @@
+/*
+Package am git-am - Apply a series of patches from a mailbox.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-am
+
+	git am [--signoff] [--keep] [--[no-]keep-cr] [--[no-]utf8] [--no-verify]
+		 [--[no-]3way] [--interactive] [--committer-date-is-author-date]
+		 [--ignore-date] [--ignore-space-change | --ignore-whitespace]
+		 [--whitespace=<option>] [-C<n>] [-p<n>] [--directory=<dir>]
+		 [--exclude=<path>] [--include=<path>] [--reject] [-q | --quiet]
+		 [--[no-]scissors] [-S[<keyid>]] [--patch-format=<format>]
+		 [--quoted-cr=<action>]
+		 [--empty=(stop|drop|keep)]
+		 [(<mbox> | <Maildir>)...]
+	git am (--continue | --skip | --abort | --quit | --show-current-patch[=(diff|raw)] | --allow-empty)
+
+# DESCRIPTION
+
+Splits mail messages in a mailbox into commit log message, authorship information and patches, and applies them to the current branch.
+You could think of it as a reverse operation of git-format-patch(1) run on a branch with a straight history without merges.
+
+When a patch does not apply, the command stops: the user resolves the problem, then uses --continue, --skip or --abort.
+
+ApplyInfo applies the patches read from an io.Reader, and reports the created commits and where the session stopped.
+*/
+package am
@@ -0,0 +1,206 @@
+package am
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"net/mail"
+	"strings"
+
+	"github.com/kumose-go/xgit/lsfiles"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Status The outcome of a `git am` session.
+type Status int
+
+// Statuses of a `git am` session.
+const (
+	// StatusCompleted All the patches have been applied.
+	StatusCompleted Status = iota
+	// StatusConflict The session stopped on a 3-way merge conflict (ThreeWay):
+	// resolve it, then use ContinueInfo (or SkipInfo, Abort, Quit).
+	StatusConflict
+	// StatusStopped The session stopped on a patch that does not apply, or on a message without a patch:
+	// apply it by hand then use ContinueInfo, or use SkipInfo (or Abort, Quit).
+	StatusStopped
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusCompleted:
+		return "completed"
+	case StatusConflict:
+		return "conflict"
+	case StatusStopped:
+		return "stopped"
+	default:
+		return "unknown"
+	}
+}
+
+// Result The result of a `git am` session.
+type Result struct {
+	Status Status
+	// Commits The commits created by the call, in order.
+	Commits []string
+	// Current The subject of the patch on which the session stopped, if known.
+	Current string
+	// Conflicts The conflicted paths.
+	Conflicts []string
+}
+
+// ApplyInfo Applies the patches of a mailbox read from r (ex: the output of `git format-patch --stdout`), using `git am`.
+// A patch that does not apply is not an error: it's reported by the Result.
+func ApplyInfo(ctx context.Context, r io.Reader, options ...types.Option) (*Result, error) {
+	return run(ctx, r, nil, options)
+}
+
+// ContinueInfo Continues the session in progress after the resolution of the problem, using `git am --continue`.
+// The session can stop again on another patch.
+func ContinueInfo(ctx context.Context, options ...types.Option) (*Result, error) {
+	return run(ctx, nil, Continue, options)
+}
+
+// SkipInfo Skips the current patch and continues with the rest of the mailbox, using `git am --skip`.
+// The session can stop again on another patch.
+func SkipInfo(ctx context.Context, options ...types.Option) (*Result, error) {
+	return run(ctx, nil, Skip, options)
+}
+
+func run(ctx context.Context, r io.Reader, action types.Option, options []types.Option) (*Result, error) {
+	globalOptions := types.GlobalOptions(options...)
+
+	before := resolve(ctx, "HEAD", globalOptions)
+
+	g := types.NewCmd("am")
+	g.ApplyOptions(options...)
+
+	if action != nil {
+		g.ApplyOptions(action)
+	}
+
+	if r != nil {
+		g.Stdin = r
+	}
+
+	_, err := g.Output(ctx)
+	if err == nil {
+		commits, errLog := newCommits(ctx, before, globalOptions)
+		if errLog != nil {
+			return nil, errLog
+		}
+
+		return &Result{Status: StatusCompleted, Commits: commits}, nil
+	}
+
+	// `git am` exits with the same code for a stopped session and a fatal error:
+	// the current patch only exists when a session is in progress.
+	current, errShow := currentPatch(ctx, globalOptions)
+	if errShow != nil {
+		return nil, err
+	}
+
+	commits, errLog := newCommits(ctx, before, globalOptions)
+	if errLog != nil {
+		return nil, errLog
+	}
+
+	conflicts, errLs := lsfiles.ListConflicts(ctx, globalOptions)
+	if errLs != nil {
+		return nil, err
+	}
+
+	result := &Result{Status: StatusStopped, Commits: commits, Current: current}
+
+	for _, conflict := range conflicts {
+		result.Conflicts = append(result.Conflicts, conflict.Path)
+	}
+
+	if len(result.Conflicts) > 0 {
+		result.Status = StatusConflict
+	}
+
+	return result, nil
+}
+
+// currentPatch returns the subject of the patch on which the session stopped.
+// An error is returned if there is no session in progress.
+func currentPatch(ctx context.Context, option types.Option) (string, error) {
+	g := types.NewCmd("am")
+	g.ApplyOptions(option)
+	g.ApplyOptions(ShowCurrentPatch("raw"))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return ParseSubject(output), nil
+}
+
+// ParseSubject Returns the decoded Subject header of a raw e-mail message (with or without the mbox `From ` line),
+// or an empty string if there is none.
+func ParseSubject(raw string) string {
+	reader := bufio.NewReader(strings.NewReader(raw))
+
+	if strings.HasPrefix(raw, "From ") {
+		_, _ = reader.ReadString('\n')
+	}
+
+	msg, err := mail.ReadMessage(reader)
+	if err != nil {
+		return ""
+	}
+
+	subject := msg.Header.Get("Subject")
+
+	decoded, err := new(mime.WordDecoder).DecodeHeader(subject)
+	if err != nil {
+		return subject
+	}
+
+	return decoded
+}
+
+// newCommits returns the commits reachable from HEAD but not from before, in order.
+func newCommits(ctx context.Context, before string, option types.Option) ([]string, error) {
+	g := types.NewCmd("rev-list")
+	g.ApplyOptions(option)
+	g.AddOptions("--reverse")
+
+	if before != "" {
+		g.AddOptions(before + "..HEAD")
+	} else {
+		// unborn branch: all the commits are new.
+		if resolve(ctx, "HEAD", option) == "" {
+			return nil, nil
+		}
+
+		g.AddOptions("HEAD")
+	}
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(output), nil
+}
+
+// resolve returns the object name of a ref, or an empty string if the ref does not exist.
+func resolve(ctx context.Context, ref string, option types.Option) string {
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(option)
+	g.AddOptions("--verify")
+	g.AddOptions("--quiet")
+	g.AddOptions(ref)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(output)
+}
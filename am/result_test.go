@@ -0,0 +1,120 @@
+package am
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestApplyInfo(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "a\n")
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "init")
+
+	repo.Git("checkout", "-q", "-b", "feature")
+	repo.WriteFile("b.txt", "b\n")
+	repo.Git("add", "b.txt")
+	repo.Git("commit", "-q", "-m", "add b")
+	repo.WriteFile("a.txt", "feature\n")
+	repo.Git("commit", "-q", "-am", "change a")
+	repo.WriteFile("c.txt", "c\n")
+	repo.Git("add", "c.txt")
+	repo.Git("commit", "-q", "-m", "add c")
+	patches := repo.Git("format-patch", "--stdout", "main..feature")
+
+	repo.Git("checkout", "-q", "main")
+	repo.WriteFile("a.txt", "main\n")
+	repo.Git("commit", "-q", "-am", "change a on main")
+	head := repo.Git("rev-parse", "HEAD")
+
+	ctx := context.Background()
+	options := []types.Option{
+		global.UpperC(repo.Dir),
+		global.LowerC("user.name", "test"),
+		global.LowerC("user.email", "test@example.com"),
+	}
+
+	result, err := ApplyInfo(ctx, strings.NewReader(patches+"\n"), append(options, ThreeWay, Signoff)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addB := repo.Git("rev-parse", "HEAD")
+
+	expected := &Result{Status: StatusConflict, Commits: []string{addB}, Current: "[PATCH 2/3] change a", Conflicts: []string{"a.txt"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	if !strings.Contains(repo.Git("log", "-1", "--format=%B"), "Signed-off-by: test <test@example.com>") {
+		t.Fatal("the commit must be signed off")
+	}
+
+	repo.WriteFile("a.txt", "resolved\n")
+	repo.Git("add", "a.txt")
+
+	result, err = ContinueInfo(ctx, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusCompleted || len(result.Commits) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if repo.Git("log", "-1", "--format=%s") != "add c" {
+		t.Fatal("the last patch must be applied")
+	}
+
+	// without 3-way merge, the patch does not apply.
+	repo.Git("reset", "-q", "--hard", head)
+
+	result, err = ApplyInfo(ctx, strings.NewReader(patches+"\n"), options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusStopped || len(result.Commits) != 1 || result.Current != "[PATCH 2/3] change a" || len(result.Conflicts) != 0 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	result, err = SkipInfo(ctx, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = &Result{Status: StatusCompleted, Commits: []string{repo.Git("rev-parse", "HEAD")}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	_, err = ApplyInfo(ctx, strings.NewReader(patches+"\n"), append(options, Mbox("missing.mbox"))...)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestParseSubject(t *testing.T) {
+	raw := "From 0000000000000000000000000000000000000000 Mon Sep 17 00:00:00 2001\n" +
+		"From: test <test@example.com>\n" +
+		"Subject: [PATCH] =?UTF-8?q?caf=C3=A9?=\n" +
+		" continued\n" +
+		"\n" +
+		"body\n"
+
+	subject := ParseSubject(raw)
+	if subject != "[PATCH] café continued" {
+		t.Fatalf("Got: %s, expected: %s.", subject, "[PATCH] café continued")
+	}
+
+	if ParseSubject("garbage") != "" {
+		t.Fatal("expected an empty subject")
+	}
+}
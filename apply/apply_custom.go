@@ -0,0 +1,15 @@
+package apply
+
+import (
+	"github.com/kumose-go/xgit/types"
+)
+
+// Patches The files to read the patch from. - can be used to read from the standard input.
+// [<patch>...]
+func Patches(patches ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, patch := range patches {
+			g.AddOptions(patch)
+		}
+	}
+}
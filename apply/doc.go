@@ -0,0 +1,31 @@
+/*
+Package apply git-apply - Apply a patch to files and/or to the index.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-apply
+
+	git apply [--stat] [--numstat] [--summary] [--check]
+		  [--index | --intent-to-add] [--3way] [--ours | --theirs | --union]
+		  [--apply] [--no-add] [--build-fake-ancestor=<file>] [-R | --reverse]
+		  [--allow-binary-replacement | --binary] [--reject] [-z]
+		  [-p<n>] [-C<n>] [--inaccurate-eof] [--recount] [--cached]
+		  [--ignore-space-change | --ignore-whitespace]
+		  [--whitespace=(nowarn|warn|fix|error|error-all)]
+		  [--exclude=<path>] [--include=<path>] [--directory=<root>]
+		  [--verbose | --quiet] [--unsafe-paths] [--allow-empty] [<patch>...]
+
+# DESCRIPTION
+
+Reads the supplied diff output (i.e. "a patch") and applies it to files.
+When running from a subdirectory in a repository, patched paths outside the directory are ignored.
+With the --index option the patch is also applied to the index, and with the --cached option the patch is only applied to the index.
+Without these options, the command applies the patch only to files, and does not require them to be in a Git repository.
+
+This command applies the patch but does not create a commit.
+Use git-am(1) to create commits from patches generated by git-format-patch(1) and/or received by email.
+
+PatchInfo applies a patch read from an io.Reader and reports the applied, rejected and conflicted paths,
+and NumStat returns the line counts of a patch.
+*/
+package apply
@@ -0,0 +1,67 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/log"
+	"github.com/kumose-go/xgit/types"
+)
+
+// NumStat Returns the number of added and deleted lines of each file of the patch read from r,
+// using `git apply --numstat -z`: the patch is not applied.
+// FileStat.OldPath is always empty (see ParseNumStat).
+func NumStat(ctx context.Context, r io.Reader, options ...types.Option) ([]log.FileStat, error) {
+	g := types.NewCmd("apply")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Numstat, Z)
+	g.Stdin = r
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseNumStat(output)
+}
+
+// ParseNumStat Parses the output of `git apply --numstat -z`.
+// git only gives the new path of a renamed or copied file: FileStat.OldPath is always empty.
+func ParseNumStat(output string) ([]log.FileStat, error) {
+	if output == "" {
+		return nil, nil
+	}
+
+	var stats []log.FileStat
+
+	tokens := strings.Split(strings.TrimSuffix(output, "\x00"), "\x00")
+
+	for _, token := range tokens {
+		parts := strings.SplitN(token, "\t", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid numstat record: %q", token)
+		}
+
+		stat := log.FileStat{Path: parts[2]}
+
+		if parts[0] == "-" && parts[1] == "-" {
+			stat.Binary = true
+		} else {
+			var errAdded, errDeleted error
+
+			stat.Added, errAdded = strconv.Atoi(parts[0])
+			stat.Deleted, errDeleted = strconv.Atoi(parts[1])
+
+			if errAdded != nil || errDeleted != nil {
+				return nil, fmt.Errorf("invalid numstat record: %q", token)
+			}
+		}
+
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
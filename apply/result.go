@@ -0,0 +1,127 @@
+package apply
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// exitCodeFailed the exit code of `git apply` when a patch does not apply.
+const exitCodeFailed = 1
+
+// Result The paths touched by a patch.
+type Result struct {
+	// Applied The paths patched cleanly (with Check, the paths that would be patched).
+	Applied []string
+	// Rejected The paths with rejected hunks (Reject): the other hunks are applied, the rejected ones are written to `<path>.rej`.
+	Rejected []string
+	// Conflicts The paths with conflicts (ThreeWay): the conflict markers are left in the files.
+	Conflicts []string
+}
+
+// OK Returns true if the whole patch has been applied.
+func (r *Result) OK() bool {
+	return len(r.Rejected) == 0 && len(r.Conflicts) == 0
+}
+
+// PatchInfo Applies the patch read from r (ex: the output of `git diff`), using `git apply --verbose`.
+// Rejected hunks (Reject) and conflicts (ThreeWay) are not errors: they're reported by the Result.
+// Without these options, a patch that does not apply is an error, and nothing is applied.
+// The Stat, Numstat, Summary and Quiet options must not be used.
+func PatchInfo(ctx context.Context, r io.Reader, options ...types.Option) (*Result, error) {
+	g := types.NewCmd("apply")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Verbose)
+	g.Stdin = r
+	g.Env = append(g.Env, "LC_ALL=C")
+
+	var stdout strings.Builder
+
+	g.Stdout = &stdout
+
+	stderr, err := g.Exec(ctx, g.Base, g.Debug, slices.Concat(g.BaseOptions, g.Options)...)
+	if err != nil && types.ExitCode(err) != exitCodeFailed {
+		return nil, &types.Error{Err: err, Stderr: stderr}
+	}
+
+	result := Parse(stderr, slices.Contains(g.Options, "--check"))
+
+	if err != nil && result.OK() {
+		return nil, &types.Error{Err: err, Stderr: stderr}
+	}
+
+	return result, nil
+}
+
+// Parse Parses the messages of `git apply --verbose` (standard error, with LC_ALL=C).
+// check must be true for the output of `git apply --check`: the checked paths are reported as applied.
+func Parse(output string, check bool) *Result {
+	var checked, applied []string
+
+	result := &Result{}
+
+	for _, line := range strings.Split(output, "\n") {
+		switch {
+		case strings.HasPrefix(line, "Checking patch ") && strings.HasSuffix(line, "..."):
+			checked = append(checked, unquote(strings.TrimSuffix(strings.TrimPrefix(line, "Checking patch "), "...")))
+
+		case strings.HasPrefix(line, "Applied patch to '") && strings.HasSuffix(line, "' with conflicts."):
+			result.Conflicts = appendPath(result.Conflicts, strings.TrimSuffix(strings.TrimPrefix(line, "Applied patch to '"), "' with conflicts."))
+
+		case strings.HasPrefix(line, "U "):
+			result.Conflicts = appendPath(result.Conflicts, unquote(strings.TrimPrefix(line, "U ")))
+
+		case strings.HasPrefix(line, "Applied patch to '") && strings.HasSuffix(line, "' cleanly."):
+			applied = append(applied, strings.TrimSuffix(strings.TrimPrefix(line, "Applied patch to '"), "' cleanly."))
+
+		case strings.HasPrefix(line, "Applied patch ") && strings.HasSuffix(line, " cleanly."):
+			applied = append(applied, unquote(strings.TrimSuffix(strings.TrimPrefix(line, "Applied patch "), " cleanly.")))
+
+		case strings.HasPrefix(line, "Applying patch ") && strings.Contains(line, " with "):
+			// `Applying patch <path> with <n> reject(s)...`
+			path := strings.TrimPrefix(line, "Applying patch ")
+			path = path[:strings.LastIndex(path, " with ")]
+
+			result.Rejected = appendPath(result.Rejected, unquote(path))
+		}
+	}
+
+	if check {
+		applied = checked
+	}
+
+	// a 3-way merge with conflicts is also reported as applied.
+	for _, path := range applied {
+		if !slices.Contains(result.Conflicts, path) && !slices.Contains(result.Rejected, path) {
+			result.Applied = appendPath(result.Applied, path)
+		}
+	}
+
+	return result
+}
+
+func appendPath(paths []string, path string) []string {
+	if slices.Contains(paths, path) {
+		return paths
+	}
+
+	return append(paths, path)
+}
+
+// unquote decodes a C-quoted path.
+func unquote(path string) string {
+	if !strings.HasPrefix(path, `"`) {
+		return path
+	}
+
+	unquoted, err := strconv.Unquote(path)
+	if err != nil {
+		return path
+	}
+
+	return unquoted
+}
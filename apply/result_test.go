@@ -0,0 +1,108 @@
+package apply
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	xlog "github.com/kumose-go/xgit/log"
+)
+
+func TestPatchInfo(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("f.txt", "a\n")
+	repo.WriteFile("g.txt", "g\n")
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "init")
+
+	repo.WriteFile("f.txt", "b\n")
+	repo.WriteFile("g.txt", "h\n")
+	patch := repo.Git("diff") + "\n"
+	repo.Git("reset", "-q", "--hard")
+
+	ctx := context.Background()
+
+	stats, err := NumStat(ctx, strings.NewReader(patch), global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedStats := []xlog.FileStat{{Path: "f.txt", Added: 1, Deleted: 1}, {Path: "g.txt", Added: 1, Deleted: 1}}
+	if !reflect.DeepEqual(stats, expectedStats) {
+		t.Fatalf("Got: %+v, expected: %+v.", stats, expectedStats)
+	}
+
+	result, err := PatchInfo(ctx, strings.NewReader(patch), global.UpperC(repo.Dir), Check)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Result{Applied: []string{"f.txt", "g.txt"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	// f.txt changes: the patch only applies partially.
+	repo.WriteFile("f.txt", "c\n")
+	repo.Git("commit", "-q", "-am", "change f")
+
+	_, err = PatchInfo(ctx, strings.NewReader(patch), global.UpperC(repo.Dir))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	result, err = PatchInfo(ctx, strings.NewReader(patch), global.UpperC(repo.Dir), Reject)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = &Result{Applied: []string{"g.txt"}, Rejected: []string{"f.txt"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	if _, err = os.Stat(filepath.Join(repo.Dir, "f.txt.rej")); err != nil {
+		t.Fatal(err)
+	}
+
+	repo.Git("reset", "-q", "--hard")
+	repo.Git("clean", "-q", "-f")
+
+	result, err = PatchInfo(ctx, strings.NewReader(patch), global.UpperC(repo.Dir), ThreeWay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = &Result{Applied: []string{"g.txt"}, Conflicts: []string{"f.txt"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+}
+
+func TestParseNumStat(t *testing.T) {
+	stats, err := ParseNumStat("1\t2\ta.txt\x00-\t-\timage.png\x000\t0\tnew.txt\x00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []xlog.FileStat{
+		{Path: "a.txt", Added: 1, Deleted: 2},
+		{Path: "image.png", Binary: true},
+		{Path: "new.txt"},
+	}
+
+	if !reflect.DeepEqual(stats, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", stats, expected)
+	}
+
+	_, err = ParseNumStat("1\t2\x00")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,59 @@
+package archive
+
+import (
+	"fmt"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Formats of the archive (see Format).
+const (
+	FormatTar   = "tar"
+	FormatTarGz = "tar.gz"
+	FormatTgz   = "tgz"
+	FormatZip   = "zip"
+)
+
+// AddVirtualFile Add the specified contents to the archive.
+// Can be repeated to add multiple files.
+// The path of the file in the archive is built by concatenating the value of the last --prefix option (if any) before this --add-virtual-file and <path>.
+// --add-virtual-file=<path>:<content>
+func AddVirtualFile(path, content string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(fmt.Sprintf("--add-virtual-file=%s:%s", path, content))
+	}
+}
+
+// CompressionLevel The compression level of the zip and tar.gz formats: from 0 (store only) to 9 (best).
+// -<level>
+func CompressionLevel(level string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("-" + level)
+	}
+}
+
+// TreeIsh The tree or commit to produce an archive for.
+// <tree-ish>
+func TreeIsh(treeIsh string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(treeIsh)
+	}
+}
+
+// PathSpecs Without an optional path parameter, all files and subdirectories of the current working directory are included in the archive.
+// If one or more paths are specified, only these are included.
+// Must be used after TreeIsh.
+// [--] [<path>...]
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		if len(pathSpecs) == 0 {
+			return
+		}
+
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
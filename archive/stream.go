@@ -0,0 +1,27 @@
+package archive
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Stream Writes the archive of a tree-ish (limited to the paths, if any) to w, as it is produced by `git archive`:
+// the archive is not buffered in memory.
+// The files with the export-ignore attribute are excluded, and the export-subst attribute is applied.
+// The Output option must not be used.
+func Stream(ctx context.Context, w io.Writer, treeIsh string, paths []string, options ...types.Option) error {
+	g := types.NewCmd("archive")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(TreeIsh(treeIsh), PathSpecs(paths...))
+	g.Stdout = w
+
+	stderr, err := g.Exec(ctx, g.Base, g.Debug, slices.Concat(g.BaseOptions, g.Options)...)
+	if err != nil {
+		return &types.Error{Err: err, Stderr: stderr}
+	}
+
+	return nil
+}
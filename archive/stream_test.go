@@ -0,0 +1,117 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestStream(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	files := map[string]string{
+		".gitattributes":  "internal/** export-ignore\n",
+		"README.md":       "readme",
+		"src/main.go":     "package main",
+		"internal/key.go": "secret",
+	}
+
+	for name, content := range files {
+		repo.WriteFile(name, content)
+	}
+
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "init")
+	repo.Git("tag", "v1.0")
+
+	var buf bytes.Buffer
+
+	err := Stream(context.Background(), &buf, "v1.0", nil, global.UpperC(repo.Dir), Format(FormatTarGz), AddVirtualFile("project-1.0/VERSION", "1.0"), Prefix("project-1.0/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contents := map[string]string{}
+
+	reader := tar.NewReader(gz)
+
+	for {
+		header, errNext := reader.Next()
+		if errors.Is(errNext, io.EOF) {
+			break
+		}
+
+		if errNext != nil {
+			t.Fatal(errNext)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, errRead := io.ReadAll(reader)
+		if errRead != nil {
+			t.Fatal(errRead)
+		}
+
+		contents[header.Name] = string(content)
+	}
+
+	expected := map[string]string{
+		"project-1.0/.gitattributes": "internal/** export-ignore\n",
+		"project-1.0/README.md":      "readme",
+		"project-1.0/src/main.go":    "package main",
+		"project-1.0/VERSION":        "1.0",
+	}
+
+	if !reflect.DeepEqual(contents, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", contents, expected)
+	}
+
+	buf.Reset()
+
+	err = Stream(context.Background(), &buf, "HEAD", []string{"src", "README.md"}, global.UpperC(repo.Dir), Format(FormatZip))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+
+	for _, file := range zr.File {
+		if !strings.HasSuffix(file.Name, "/") {
+			names = append(names, file.Name)
+		}
+	}
+
+	sort.Strings(names)
+
+	if !reflect.DeepEqual(names, []string{"README.md", "src/main.go"}) {
+		t.Fatalf("Got: %+v, expected: [README.md src/main.go].", names)
+	}
+
+	err = Stream(context.Background(), io.Discard, "unknown", nil, global.UpperC(repo.Dir))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -219,6 +219,326 @@ func StashWithContext(ctx context.Context, options ...types.Option) (string, err
 	return command(ctx, "stash", options...)
 }
 
+// Log https://git-scm.com/docs/git-log
+func Log(options ...types.Option) (string, error) {
+	return command(context.Background(), "log", options...)
+}
+
+// LogWithContext https://git-scm.com/docs/git-log
+func LogWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "log", options...)
+}
+
+// Diff https://git-scm.com/docs/git-diff
+func Diff(options ...types.Option) (string, error) {
+	return command(context.Background(), "diff", options...)
+}
+
+// DiffWithContext https://git-scm.com/docs/git-diff
+func DiffWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "diff", options...)
+}
+
+// Blame https://git-scm.com/docs/git-blame
+func Blame(options ...types.Option) (string, error) {
+	return command(context.Background(), "blame", options...)
+}
+
+// BlameWithContext https://git-scm.com/docs/git-blame
+func BlameWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "blame", options...)
+}
+
+// ForEachRef https://git-scm.com/docs/git-for-each-ref
+func ForEachRef(options ...types.Option) (string, error) {
+	return command(context.Background(), "for-each-ref", options...)
+}
+
+// ForEachRefWithContext https://git-scm.com/docs/git-for-each-ref
+func ForEachRefWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "for-each-ref", options...)
+}
+
+// CatFile https://git-scm.com/docs/git-cat-file
+func CatFile(options ...types.Option) (string, error) {
+	return command(context.Background(), "cat-file", options...)
+}
+
+// CatFileWithContext https://git-scm.com/docs/git-cat-file
+func CatFileWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "cat-file", options...)
+}
+
+// RevList https://git-scm.com/docs/git-rev-list
+func RevList(options ...types.Option) (string, error) {
+	return command(context.Background(), "rev-list", options...)
+}
+
+// RevListWithContext https://git-scm.com/docs/git-rev-list
+func RevListWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "rev-list", options...)
+}
+
+// Describe https://git-scm.com/docs/git-describe
+func Describe(options ...types.Option) (string, error) {
+	return command(context.Background(), "describe", options...)
+}
+
+// DescribeWithContext https://git-scm.com/docs/git-describe
+func DescribeWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "describe", options...)
+}
+
+// MergeBase https://git-scm.com/docs/git-merge-base
+func MergeBase(options ...types.Option) (string, error) {
+	return command(context.Background(), "merge-base", options...)
+}
+
+// MergeBaseWithContext https://git-scm.com/docs/git-merge-base
+func MergeBaseWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "merge-base", options...)
+}
+
+// CherryPick https://git-scm.com/docs/git-cherry-pick
+func CherryPick(options ...types.Option) (string, error) {
+	return command(context.Background(), "cherry-pick", options...)
+}
+
+// CherryPickWithContext https://git-scm.com/docs/git-cherry-pick
+func CherryPickWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "cherry-pick", options...)
+}
+
+// Revert https://git-scm.com/docs/git-revert
+func Revert(options ...types.Option) (string, error) {
+	return command(context.Background(), "revert", options...)
+}
+
+// RevertWithContext https://git-scm.com/docs/git-revert
+func RevertWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "revert", options...)
+}
+
+// Switch https://git-scm.com/docs/git-switch
+func Switch(options ...types.Option) (string, error) {
+	return command(context.Background(), "switch", options...)
+}
+
+// SwitchWithContext https://git-scm.com/docs/git-switch
+func SwitchWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "switch", options...)
+}
+
+// Restore https://git-scm.com/docs/git-restore
+func Restore(options ...types.Option) (string, error) {
+	return command(context.Background(), "restore", options...)
+}
+
+// RestoreWithContext https://git-scm.com/docs/git-restore
+func RestoreWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "restore", options...)
+}
+
+// Rm https://git-scm.com/docs/git-rm
+func Rm(options ...types.Option) (string, error) {
+	return command(context.Background(), "rm", options...)
+}
+
+// RmWithContext https://git-scm.com/docs/git-rm
+func RmWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "rm", options...)
+}
+
+// Mv https://git-scm.com/docs/git-mv
+func Mv(options ...types.Option) (string, error) {
+	return command(context.Background(), "mv", options...)
+}
+
+// MvWithContext https://git-scm.com/docs/git-mv
+func MvWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "mv", options...)
+}
+
+// Clean https://git-scm.com/docs/git-clean
+func Clean(options ...types.Option) (string, error) {
+	return command(context.Background(), "clean", options...)
+}
+
+// CleanWithContext https://git-scm.com/docs/git-clean
+func CleanWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "clean", options...)
+}
+
+// Submodule https://git-scm.com/docs/git-submodule
+func Submodule(options ...types.Option) (string, error) {
+	return command(context.Background(), "submodule", options...)
+}
+
+// SubmoduleWithContext https://git-scm.com/docs/git-submodule
+func SubmoduleWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "submodule", options...)
+}
+
+// SparseCheckout https://git-scm.com/docs/git-sparse-checkout
+func SparseCheckout(options ...types.Option) (string, error) {
+	return command(context.Background(), "sparse-checkout", options...)
+}
+
+// SparseCheckoutWithContext https://git-scm.com/docs/git-sparse-checkout
+func SparseCheckoutWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "sparse-checkout", options...)
+}
+
+// Bisect https://git-scm.com/docs/git-bisect
+func Bisect(options ...types.Option) (string, error) {
+	return command(context.Background(), "bisect", options...)
+}
+
+// BisectWithContext https://git-scm.com/docs/git-bisect
+func BisectWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "bisect", options...)
+}
+
+// Reflog https://git-scm.com/docs/git-reflog
+func Reflog(options ...types.Option) (string, error) {
+	return command(context.Background(), "reflog", options...)
+}
+
+// ReflogWithContext https://git-scm.com/docs/git-reflog
+func ReflogWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "reflog", options...)
+}
+
+// GC https://git-scm.com/docs/git-gc
+func GC(options ...types.Option) (string, error) {
+	return command(context.Background(), "gc", options...)
+}
+
+// GCWithContext https://git-scm.com/docs/git-gc
+func GCWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "gc", options...)
+}
+
+// Maintenance https://git-scm.com/docs/git-maintenance
+func Maintenance(options ...types.Option) (string, error) {
+	return command(context.Background(), "maintenance", options...)
+}
+
+// MaintenanceWithContext https://git-scm.com/docs/git-maintenance
+func MaintenanceWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "maintenance", options...)
+}
+
+// Repack https://git-scm.com/docs/git-repack
+func Repack(options ...types.Option) (string, error) {
+	return command(context.Background(), "repack", options...)
+}
+
+// RepackWithContext https://git-scm.com/docs/git-repack
+func RepackWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "repack", options...)
+}
+
+// Prune https://git-scm.com/docs/git-prune
+func Prune(options ...types.Option) (string, error) {
+	return command(context.Background(), "prune", options...)
+}
+
+// PruneWithContext https://git-scm.com/docs/git-prune
+func PruneWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "prune", options...)
+}
+
+// Fsck https://git-scm.com/docs/git-fsck
+func Fsck(options ...types.Option) (string, error) {
+	return command(context.Background(), "fsck", options...)
+}
+
+// FsckWithContext https://git-scm.com/docs/git-fsck
+func FsckWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "fsck", options...)
+}
+
+// CountObjects https://git-scm.com/docs/git-count-objects
+func CountObjects(options ...types.Option) (string, error) {
+	return command(context.Background(), "count-objects", options...)
+}
+
+// CountObjectsWithContext https://git-scm.com/docs/git-count-objects
+func CountObjectsWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "count-objects", options...)
+}
+
+// Archive https://git-scm.com/docs/git-archive
+func Archive(options ...types.Option) (string, error) {
+	return command(context.Background(), "archive", options...)
+}
+
+// ArchiveWithContext https://git-scm.com/docs/git-archive
+func ArchiveWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "archive", options...)
+}
+
+// Bundle https://git-scm.com/docs/git-bundle
+func Bundle(options ...types.Option) (string, error) {
+	return command(context.Background(), "bundle", options...)
+}
+
+// BundleWithContext https://git-scm.com/docs/git-bundle
+func BundleWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "bundle", options...)
+}
+
+// FormatPatch https://git-scm.com/docs/git-format-patch
+func FormatPatch(options ...types.Option) (string, error) {
+	return command(context.Background(), "format-patch", options...)
+}
+
+// FormatPatchWithContext https://git-scm.com/docs/git-format-patch
+func FormatPatchWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "format-patch", options...)
+}
+
+// Am https://git-scm.com/docs/git-am
+func Am(options ...types.Option) (string, error) {
+	return command(context.Background(), "am", options...)
+}
+
+// AmWithContext https://git-scm.com/docs/git-am
+func AmWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "am", options...)
+}
+
+// Apply https://git-scm.com/docs/git-apply
+func Apply(options ...types.Option) (string, error) {
+	return command(context.Background(), "apply", options...)
+}
+
+// ApplyWithContext https://git-scm.com/docs/git-apply
+func ApplyWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "apply", options...)
+}
+
+// Grep https://git-scm.com/docs/git-grep
+func Grep(options ...types.Option) (string, error) {
+	return command(context.Background(), "grep", options...)
+}
+
+// GrepWithContext https://git-scm.com/docs/git-grep
+func GrepWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "grep", options...)
+}
+
+// Shortlog https://git-scm.com/docs/git-shortlog
+func Shortlog(options ...types.Option) (string, error) {
+	return command(context.Background(), "shortlog", options...)
+}
+
+// ShortlogWithContext https://git-scm.com/docs/git-shortlog
+func ShortlogWithContext(ctx context.Context, options ...types.Option) (string, error) {
+	return command(ctx, "shortlog", options...)
+}
+
 // Raw use to execute arbitrary git commands.
 func Raw(cmd string, options ...types.Option) (string, error) {
 	return command(context.Background(), cmd, options...)
@@ -263,9 +583,28 @@ func LogOutput(w io.Writer) types.Option {
 }
 
 // CmdExecutor Allow to override the Git command call (useful for testing purpose).
+// The executor returns the output of the command: the typed helpers of the command packages receive it as the standard output
+// (see types.OutputExecutor).
+// The executor has no access to the standard input and the environment of the command: use CmdExecutorFactory if they are needed.
 func CmdExecutor(executor types.Executor) types.Option {
 	return func(g *types.Cmd) {
-		g.Executor = executor
+		g.Executor = types.OutputExecutor(g, executor)
+	}
+}
+
+// CmdExecutorFactory Allow to override the Git command call with an executor created for the command.
+// The executor reads the standard input (Cmd.Stdin), the standard output (Cmd.Stdout) and the environment (Cmd.Env) from the command
+// (see types.Executor).
+func CmdExecutorFactory(factory func(g *types.Cmd) types.Executor) types.Option {
+	return func(g *types.Cmd) {
+		g.Executor = factory(g)
+	}
+}
+
+// Env Additional environment variables of the command (`KEY=value`).
+func Env(env ...string) types.Option {
+	return func(g *types.Cmd) {
+		g.Env = append(g.Env, env...)
 	}
 }
 
@@ -0,0 +1,104 @@
+package xgit_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit"
+	"github.com/kumose-go/xgit/apply"
+	"github.com/kumose-go/xgit/clean"
+	"github.com/kumose-go/xgit/config"
+	"github.com/kumose-go/xgit/lsfiles"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestCmdExecutor(t *testing.T) {
+	executor := xgit.CmdExecutor(func(_ context.Context, _ string, _ bool, _ ...string) (string, error) {
+		return "100644 78981922613b2afb6025042ff6bd878ac1994e85 0\ta.txt\x00", nil
+	})
+
+	var entries []lsfiles.Entry
+
+	for entry, err := range lsfiles.Entries(context.Background(), executor) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	expected := []lsfiles.Entry{{Mode: "100644", Object: "78981922613b2afb6025042ff6bd878ac1994e85", Stage: lsfiles.StageMerged, Path: "a.txt"}}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	errExec := errors.New("exit status 128")
+
+	_, err := config.Load(context.Background(), xgit.CmdExecutor(func(_ context.Context, _ string, _ bool, _ ...string) (string, error) {
+		return "fatal: not in a git directory", errExec
+	}))
+
+	if !errors.Is(err, errExec) || err.Error() != "exit status 128: fatal: not in a git directory" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the standard input cannot be given to the executor.
+	_, err = apply.NumStat(context.Background(), strings.NewReader("patch"), executor)
+	if !errors.Is(err, types.ErrStdinUnsupported) {
+		t.Fatalf("Got: %v, expected: %v.", err, types.ErrStdinUnsupported)
+	}
+}
+
+func TestCmdExecutorFactory(t *testing.T) {
+	var (
+		stdin string
+		env   []string
+	)
+
+	factory := xgit.CmdExecutorFactory(func(g *types.Cmd) types.Executor {
+		return func(_ context.Context, _ string, _ bool, args ...string) (string, error) {
+			env = g.Env
+
+			if g.Stdin != nil {
+				input, err := io.ReadAll(g.Stdin)
+				if err != nil {
+					return "", err
+				}
+
+				stdin = string(input)
+			}
+
+			output := "Would remove a.txt\n"
+			if slices.Contains(args, "--numstat") {
+				output = "1\t0\ta.txt\x00"
+			}
+
+			_, err := io.WriteString(g.Stdout, output)
+
+			return "", err
+		}
+	})
+
+	stats, err := apply.NumStat(context.Background(), strings.NewReader("patch"), factory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stdin != "patch" || len(stats) != 1 || stats[0].Path != "a.txt" {
+		t.Fatalf("unexpected call: %q %+v", stdin, stats)
+	}
+
+	paths, err := clean.Preview(context.Background(), factory)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(paths, []string{"a.txt"}) || !slices.Contains(env, "LC_ALL=C") {
+		t.Fatalf("unexpected call: %q %q", paths, env)
+	}
+}
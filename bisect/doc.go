@@ -0,0 +1,30 @@
+/*
+Package bisect git-bisect - Use binary search to find the commit that introduced a bug.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-bisect
+
+	git bisect start [--term-(new|bad)=<term-new> --term-(old|good)=<term-old>]
+			 [--no-checkout] [--first-parent] [<bad> [<good>...]] [--] [<pathspec>...]
+	git bisect (bad|new|<term-new>) [<rev>]
+	git bisect (good|old|<term-old>) [<rev>...]
+	git bisect terms [--term-good | --term-bad]
+	git bisect skip [(<rev>|<range>)...]
+	git bisect reset [<commit>]
+	git bisect (visualize|view)
+	git bisect replay <logfile>
+	git bisect log
+	git bisect run <cmd> [<arg>...]
+	git bisect help
+
+# DESCRIPTION
+
+This command uses a binary search algorithm to find which commit in your project's history introduced a bug.
+You use it by first telling it a "bad" commit that is known to contain the bug, and a "good" commit that is known to be before the bug was introduced.
+Then git bisect picks a commit between those two endpoints and asks you whether the selected commit is "good" or "bad".
+It continues narrowing down the range until it finds the exact commit that introduced the change.
+
+Run drives a bisection with a Go predicate instead of a shell script (`git bisect run`).
+*/
+package bisect
@@ -0,0 +1,190 @@
+package bisect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// exitOnlySkipped the exit code when only skipped commits are left to test.
+const exitOnlySkipped = 2
+
+var (
+	expFirstBad = regexp.MustCompile(`(?m)^([0-9a-f]{40,64}) is the first \S+ commit$`)
+	expHash     = regexp.MustCompile(`(?m)^[0-9a-f]{40,64}$`)
+)
+
+// Verdict The result of the test of a commit.
+type Verdict int
+
+// Verdicts.
+const (
+	// VerdictGood The commit is before the change (good, or old).
+	VerdictGood Verdict = iota
+	// VerdictBad The commit is after the change (bad, or new).
+	VerdictBad
+	// VerdictSkip The commit cannot be tested.
+	VerdictSkip
+)
+
+func (v Verdict) String() string {
+	switch v {
+	case VerdictGood:
+		return "good"
+	case VerdictBad:
+		return "bad"
+	case VerdictSkip:
+		return "skip"
+	default:
+		return fmt.Sprintf("Verdict(%d)", int(v))
+	}
+}
+
+// Predicate Tests a commit: the commit is checked out, or is BISECT_HEAD with NoCheckout.
+type Predicate func(ctx context.Context, commit string) (Verdict, error)
+
+// Repository The repository to bisect (ex: *xgit.Repo).
+type Repository interface {
+	// Options Returns the global options of the repository, followed by the given options.
+	Options(options ...types.Option) []types.Option
+}
+
+// Result The result of a bisection.
+type Result struct {
+	// Commit The first bad commit, empty if only skipped commits are left to test.
+	Commit string
+	// Candidates The commits that could be the first bad commit, when only skipped commits are left to test.
+	Candidates []string
+	// Log The log of the bisection, can be replayed with Replay.
+	Log string
+}
+
+// TermNames The terms used for the old and new states.
+type TermNames struct {
+	// Old The term of the commits before the change ("good" by default).
+	Old string
+	// New The term of the commits after the change ("bad" by default).
+	New string
+}
+
+// ReadTerms Returns the terms of the current bisection, using `git bisect terms`.
+func ReadTerms(ctx context.Context, options ...types.Option) (TermNames, error) {
+	oldTerm, err := output(ctx, options, Terms(TermGood))
+	if err != nil {
+		return TermNames{}, err
+	}
+
+	newTerm, err := output(ctx, options, Terms(TermBad))
+	if err != nil {
+		return TermNames{}, err
+	}
+
+	return TermNames{Old: strings.TrimSpace(oldTerm), New: strings.TrimSpace(newTerm)}, nil
+}
+
+// Run Finds the first bad commit between good and bad: each candidate is checked out and tested by the predicate.
+// The options are the start options (TermOld, TermNew, NoCheckout, FirstParent).
+// The bisection is always reset at the end, even if the predicate fails or the context is canceled.
+func Run(ctx context.Context, repo Repository, good, bad string, predicate Predicate, options ...types.Option) (result Result, err error) {
+	globals := repo.Options()
+
+	defer func() {
+		_, errReset := output(context.WithoutCancel(ctx), globals, Reset(""))
+		if errReset != nil {
+			err = errors.Join(err, fmt.Errorf("bisect reset: %w", errReset))
+		}
+	}()
+
+	out, err := output(ctx, globals, Start(bad, []string{good}, nil, options...))
+	if err != nil {
+		return Result{}, err
+	}
+
+	terms, err := ReadTerms(ctx, globals...)
+	if err != nil {
+		return Result{}, err
+	}
+
+	for !expFirstBad.MatchString(out) {
+		commit, errCommit := current(ctx, globals)
+		if errCommit != nil {
+			return Result{}, errCommit
+		}
+
+		verdict, errPredicate := predicate(ctx, commit)
+		if errPredicate != nil {
+			return Result{}, fmt.Errorf("commit %s: %w", commit, errPredicate)
+		}
+
+		out, err = output(ctx, globals, mark(verdict, terms, commit))
+		if types.ExitCode(err) == exitOnlySkipped {
+			result.Candidates = expHash.FindAllString(out, -1)
+			break
+		}
+
+		if err != nil {
+			return Result{}, err
+		}
+	}
+
+	if match := expFirstBad.FindStringSubmatch(out); match != nil {
+		result.Commit = match[1]
+	}
+
+	result.Log, err = output(ctx, globals, Log())
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, nil
+}
+
+// mark returns the subcommand marking a commit with a verdict.
+func mark(verdict Verdict, terms TermNames, commit string) types.Option {
+	switch verdict {
+	case VerdictGood:
+		return subCommand(terms.Old, []string{commit})
+	case VerdictBad:
+		return subCommand(terms.New, []string{commit})
+	default:
+		return Skip(commit)
+	}
+}
+
+// current returns the commit to test: BISECT_HEAD with NoCheckout, HEAD otherwise.
+func current(ctx context.Context, options []types.Option) (string, error) {
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(options...)
+	g.AddOptions("--verify")
+	g.AddOptions("--quiet")
+	g.AddOptions("BISECT_HEAD")
+
+	commit, err := g.Output(ctx)
+	if err == nil {
+		return strings.TrimSpace(commit), nil
+	}
+
+	g = types.NewCmd("rev-parse")
+	g.ApplyOptions(options...)
+	g.AddOptions("--verify")
+	g.AddOptions("HEAD")
+
+	commit, err = g.Output(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(commit), nil
+}
+
+func output(ctx context.Context, options []types.Option, subCommand types.Option) (string, error) {
+	g := types.NewCmd("bisect")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(subCommand)
+
+	return g.Output(ctx)
+}
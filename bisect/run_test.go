@@ -0,0 +1,110 @@
+package bisect
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+type testRepo string
+
+func (r testRepo) Options(options ...types.Option) []types.Option {
+	return append([]types.Option{global.UpperC(string(r))}, options...)
+}
+
+func TestRun(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	var commits []string
+
+	for i := 1; i <= 10; i++ {
+		repo.WriteFile("version", strconv.Itoa(i))
+		repo.Git("add", "version")
+		repo.Git("commit", "-q", "-m", "version "+strconv.Itoa(i))
+		commits = append(commits, repo.Git("rev-parse", "HEAD"))
+	}
+
+	// the bug is introduced by the 7th commit.
+	predicate := func(_ context.Context, _ string) (Verdict, error) {
+		version, errRead := os.ReadFile(filepath.Join(repo.Dir, "version"))
+		if errRead != nil {
+			return 0, errRead
+		}
+
+		if v, _ := strconv.Atoi(string(version)); v >= 7 {
+			return VerdictBad, nil
+		}
+
+		return VerdictGood, nil
+	}
+
+	result, err := Run(context.Background(), testRepo(repo.Dir), commits[0], "main", predicate)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Commit != commits[6] || result.Candidates != nil {
+		t.Fatalf("Got: %+v, expected: %s.", result, commits[6])
+	}
+
+	if !strings.Contains(result.Log, "# first bad commit: ["+commits[6]+"]") {
+		t.Fatalf("unexpected log: %s", result.Log)
+	}
+
+	if branch := repo.Git("symbolic-ref", "--short", "HEAD"); branch != "main" {
+		t.Fatalf("Got: %s, expected: main (reset).", branch)
+	}
+
+	// custom terms, without checkout: the predicate uses the commit.
+	predicate = func(_ context.Context, commit string) (Verdict, error) {
+		if commit == commits[7] || commit == commits[8] {
+			return VerdictSkip, nil
+		}
+
+		for _, c := range commits[7:] {
+			if c == commit {
+				return VerdictBad, nil
+			}
+		}
+
+		return VerdictGood, nil
+	}
+
+	result, err = Run(context.Background(), testRepo(repo.Dir), commits[0], "main", predicate, NoCheckout, TermOld("fast"), TermNew("slow"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{commits[7], commits[8], commits[9]}
+
+	sort.Strings(expected)
+	sort.Strings(result.Candidates)
+
+	if result.Commit != "" || !reflect.DeepEqual(result.Candidates, expected) {
+		t.Fatalf("Got: %+v, expected: candidates %v.", result, expected)
+	}
+
+	// the predicate fails: the bisection is reset.
+	errTest := errors.New("test failure")
+
+	_, err = Run(context.Background(), testRepo(repo.Dir), commits[0], "main", func(_ context.Context, _ string) (Verdict, error) {
+		return 0, errTest
+	})
+	if !errors.Is(err, errTest) {
+		t.Fatalf("Got: %v, expected: %v.", err, errTest)
+	}
+
+	if branch := repo.Git("symbolic-ref", "--short", "HEAD"); branch != "main" {
+		t.Fatalf("Got: %s, expected: main (reset).", branch)
+	}
+}
@@ -0,0 +1,95 @@
+package bisect
+
+import "github.com/kumose-go/xgit/types"
+
+// Start a bisection, from a bad commit (if not empty) and good commits, limited to the paths (if not empty).
+// usage: git bisect start [--term-(new|bad)=<term-new> --term-(old|good)=<term-old>] [--no-checkout] [--first-parent] [<bad> [<good>...]] [--] [<pathspec>...]
+func Start(bad string, goods, paths []string, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("start")
+		g.ApplyOptions(options...)
+
+		if bad != "" {
+			g.AddOptions(bad)
+
+			for _, good := range goods {
+				g.AddOptions(good)
+			}
+		}
+
+		if len(paths) > 0 {
+			g.AddOptions("--")
+
+			for _, path := range paths {
+				g.AddOptions(path)
+			}
+		}
+	}
+}
+
+// Bad Mark a commit (the current one if empty) as bad (or new).
+// usage: git bisect (bad|new|<term-new>) [<rev>]
+func Bad(revision string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("bad")
+
+		if revision != "" {
+			g.AddOptions(revision)
+		}
+	}
+}
+
+// Good Mark commits (the current one if none) as good (or old).
+// usage: git bisect (good|old|<term-old>) [<rev>...]
+func Good(revisions ...string) types.Option {
+	return subCommand("good", revisions)
+}
+
+// Skip commits or ranges (the current commit if none): they cannot be tested.
+// usage: git bisect skip [(<rev>|<range>)...]
+func Skip(revisions ...string) types.Option {
+	return subCommand("skip", revisions)
+}
+
+// Reset Stop the bisection, and checkout the given commit (the branch checked out before Start if empty).
+// usage: git bisect reset [<commit>]
+func Reset(commit string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("reset")
+
+		if commit != "" {
+			g.AddOptions(commit)
+		}
+	}
+}
+
+// Log Show the log of the current bisection: the commands to replay it.
+// usage: git bisect log
+func Log() types.Option {
+	return subCommand("log", nil)
+}
+
+// Replay the bisection recorded in a log file.
+// usage: git bisect replay <logfile>
+func Replay(logFile string) types.Option {
+	return subCommand("replay", []string{logFile})
+}
+
+// Terms Show the terms used for the old and new states (use TermGood or TermBad to show only one term).
+// usage: git bisect terms [--term-good | --term-bad]
+func Terms(options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("terms")
+		g.ApplyOptions(options...)
+	}
+}
+
+func subCommand(name string, args []string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(name)
+
+		for _, arg := range args {
+			g.AddOptions(arg)
+		}
+	}
+}
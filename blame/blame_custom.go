@@ -0,0 +1,41 @@
+package blame
+
+import "github.com/kumose-go/xgit/types"
+
+// DetectMoves -M[<num>]
+// Detect moved or copied lines within a file.
+// The optional <num> is the number of alphanumeric characters that Git must detect as moving/copying within a file for it to associate those lines with the parent commit (default: 20).
+func DetectMoves(num string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("-M" + num)
+	}
+}
+
+// DetectCopies -C[<num>]
+// In addition to -M, detect lines moved or copied from other files that were modified in the same commit.
+// When this option is given twice, the command additionally looks for copies from other files in the commit that creates the file.
+// When this option is given three times, the command additionally looks for copies from other files in any commit.
+// The optional <num> is the number of alphanumeric characters that Git must detect as moving/copying between files (default: 40).
+func DetectCopies(num string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("-C" + num)
+	}
+}
+
+// Revision [<rev>]
+// Annotate the file as of the given revision.
+func Revision(rev string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(rev)
+	}
+}
+
+// File [--] <file>
+// The file to annotate.
+// Must be the last option.
+func File(file string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--")
+		g.AddOptions(file)
+	}
+}
@@ -0,0 +1,22 @@
+/*
+Package blame git-blame - Show what revision and author last modified each line of a file.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-blame
+
+	git blame [-c] [-b] [-l] [--root] [-t] [-f] [-n] [-s] [-e] [-p] [-w] [--incremental]
+		    [-L <range>] [-S <revs-file>] [-M] [-C] [-C] [-C] [--since=<date>]
+		    [--ignore-rev <rev>] [--ignore-revs-file <file>]
+		    [--color-lines] [--color-by-age] [--progress] [--abbrev=<n>]
+		    [ --contents <file> ] [<rev> | --reverse <rev>..<rev>] [--] <file>
+
+# DESCRIPTION
+
+Annotates each line in the given file with information from the revision which last modified the line. Optionally, start annotating from the given revision.
+
+When specified one or more times, -L restricts annotation to the requested lines.
+
+The origin of lines is automatically followed across whole-file renames (currently there is no option to turn the rename-following off). To follow lines moved from one file to another, or to follow lines that were copied and pasted from another file, etc., see the -C and -M options.
+*/
+package blame
@@ -0,0 +1,265 @@
+package blame
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Commit The metadata of a commit, shared by all the lines blamed on it.
+type Commit struct {
+	Hash      string
+	Author    Signature
+	Committer Signature
+	Summary   string
+	// Boundary True when the commit is a boundary commit (root commit, or older than Since, or outside the range).
+	Boundary bool
+}
+
+// Signature The identity and date of an author or a committer.
+type Signature struct {
+	Name  string
+	Email string
+	When  time.Time
+}
+
+// Line A line of the blamed file.
+type Line struct {
+	// Commit The commit the line is blamed on.
+	// The lines of the same commit share the same pointer.
+	Commit *Commit
+	// OriginalLine The line number in the original file (in Commit).
+	OriginalLine int
+	// FinalLine The line number in the final file.
+	FinalLine int
+	// Filename The name of the file in Commit.
+	Filename string
+	// Previous The parent commit of Commit in which the line existed, if any.
+	Previous string
+	// PreviousFilename The name of the file in Previous.
+	PreviousFilename string
+	Content          string
+}
+
+// Lines Streams the blamed lines of a file using `git blame --porcelain`.
+// Options changing the output format (LinePorcelain excepted) are not supported.
+func Lines(ctx context.Context, file string, options ...types.Option) iter.Seq2[Line, error] {
+	return func(yield func(Line, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("blame")
+		g.ApplyOptions(Porcelain)
+		g.ApplyOptions(options...)
+		g.ApplyOptions(File(file))
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		ParsePorcelain(stdout)(yield)
+	}
+}
+
+// ParsePorcelain Streams the lines of a `--porcelain` or `--line-porcelain` output.
+func ParsePorcelain(r io.Reader) iter.Seq2[Line, error] {
+	return func(yield func(Line, error) bool) {
+		reader := &porcelainReader{r: bufio.NewReader(r), commits: map[string]*origin{}}
+
+		for {
+			line, err := reader.next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(Line{}, err)
+				return
+			}
+
+			if !yield(line, nil) {
+				return
+			}
+		}
+	}
+}
+
+// origin The last known filenames of a commit:
+// the porcelain format only repeats them when they change.
+type origin struct {
+	commit           *Commit
+	filename         string
+	previous         string
+	previousFilename string
+}
+
+type porcelainReader struct {
+	r       *bufio.Reader
+	commits map[string]*origin
+}
+
+// next reads a header `<hash> <original line> <final line> [<lines in group>]`,
+// the optional metadata lines, and the TAB-prefixed content.
+func (p *porcelainReader) next() (Line, error) {
+	header, err := p.readLine()
+	if err != nil {
+		return Line{}, err
+	}
+
+	fields := strings.Fields(header)
+	if len(fields) < 3 {
+		return Line{}, fmt.Errorf("invalid header: %q", header)
+	}
+
+	originalLine, errOrig := strconv.Atoi(fields[1])
+	finalLine, errFinal := strconv.Atoi(fields[2])
+
+	if errOrig != nil || errFinal != nil {
+		return Line{}, fmt.Errorf("invalid header: %q", header)
+	}
+
+	o, ok := p.commits[fields[0]]
+	if !ok {
+		o = &origin{commit: &Commit{Hash: fields[0]}}
+		p.commits[fields[0]] = o
+	}
+
+	var previous, previousFilename string
+
+	for {
+		line, err := p.readLine()
+		if errors.Is(err, io.EOF) {
+			return Line{}, fmt.Errorf("truncated entry: %q", header)
+		}
+
+		if err != nil {
+			return Line{}, err
+		}
+
+		if content, found := strings.CutPrefix(line, "\t"); found {
+			return Line{
+				Commit:           o.commit,
+				OriginalLine:     originalLine,
+				FinalLine:        finalLine,
+				Filename:         o.filename,
+				Previous:         o.previous,
+				PreviousFilename: o.previousFilename,
+				Content:          content,
+			}, nil
+		}
+
+		key, value, _ := strings.Cut(line, " ")
+
+		switch key {
+		case "previous":
+			previous, previousFilename, _ = strings.Cut(value, " ")
+			previousFilename = unquote(previousFilename)
+
+		case "filename":
+			// `previous` is only written just before `filename`.
+			o.filename = unquote(value)
+			o.previous, o.previousFilename = previous, previousFilename
+
+		default:
+			err = parseMetadata(o.commit, key, value)
+			if err != nil {
+				return Line{}, err
+			}
+		}
+	}
+}
+
+// readLine reads a line without its line feed.
+func (p *porcelainReader) readLine() (string, error) {
+	line, err := p.r.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", err
+	}
+
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+func parseMetadata(commit *Commit, key, value string) error {
+	var err error
+
+	switch key {
+	case "author":
+		commit.Author.Name = value
+	case "author-mail":
+		commit.Author.Email = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+	case "author-time":
+		commit.Author.When, err = parseTime(value, commit.Author.When)
+	case "author-tz":
+		commit.Author.When, err = parseZone(value, commit.Author.When)
+	case "committer":
+		commit.Committer.Name = value
+	case "committer-mail":
+		commit.Committer.Email = strings.TrimSuffix(strings.TrimPrefix(value, "<"), ">")
+	case "committer-time":
+		commit.Committer.When, err = parseTime(value, commit.Committer.When)
+	case "committer-tz":
+		commit.Committer.When, err = parseZone(value, commit.Committer.When)
+	case "summary":
+		commit.Summary = value
+	case "boundary":
+		commit.Boundary = true
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid %s: %w", key, err)
+	}
+
+	return nil
+}
+
+// parseTime parses a Unix timestamp, keeping the location of the previous value.
+func parseTime(value string, previous time.Time) (time.Time, error) {
+	sec, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(sec, 0).In(previous.Location()), nil
+}
+
+// parseZone applies a time zone offset (ex: `+0200`).
+func parseZone(value string, t time.Time) (time.Time, error) {
+	if len(value) != 5 || (value[0] != '+' && value[0] != '-') {
+		return time.Time{}, fmt.Errorf("invalid time zone %q", value)
+	}
+
+	hours, errH := strconv.Atoi(value[1:3])
+	minutes, errM := strconv.Atoi(value[3:])
+
+	if errH != nil || errM != nil {
+		return time.Time{}, fmt.Errorf("invalid time zone %q", value)
+	}
+
+	offset := hours*3600 + minutes*60
+	if value[0] == '-' {
+		offset = -offset
+	}
+
+	return t.In(time.FixedZone(value, offset)), nil
+}
+
+// unquote decodes a C-quoted filename.
+func unquote(name string) string {
+	if !strings.HasPrefix(name, `"`) {
+		return name
+	}
+
+	unquoted, err := strconv.Unquote(name)
+	if err != nil {
+		return name
+	}
+
+	return unquoted
+}
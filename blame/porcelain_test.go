@@ -0,0 +1,144 @@
+package blame
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+const porcelain = `48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec 1 1 2
+author John Doe
+author-mail <john@example.com>
+author-time 1711879200
+author-tz +0200
+committer Jane Doe
+committer-mail <jane@example.com>
+committer-time 1711965600
+committer-tz -0130
+summary init
+boundary
+filename old.txt
+	keep
+48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec 2 2
+	me
+1f3a98444d07cdc3c9d53cb16963681357f94293 4 3 1
+author John Doe
+author-mail <john@example.com>
+author-time 1711965600
+author-tz +0000
+committer John Doe
+committer-mail <john@example.com>
+committer-time 1711965600
+committer-tz +0000
+summary rename
+previous 48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec "t\303\251st.txt"
+filename new.txt
+	thanks!
+48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec 5 4 1
+	bye
+`
+
+func TestParsePorcelain(t *testing.T) {
+	lines := collect(t, ParsePorcelain(strings.NewReader(porcelain)))
+
+	if len(lines) != 4 {
+		t.Fatalf("Got: %d lines, expected: 4.", len(lines))
+	}
+
+	first := lines[0].Commit
+
+	expected := &Commit{
+		Hash:      "48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec",
+		Author:    Signature{Name: "John Doe", Email: "john@example.com", When: time.Date(2024, 3, 31, 12, 0, 0, 0, time.FixedZone("+0200", 2*3600))},
+		Committer: Signature{Name: "Jane Doe", Email: "jane@example.com", When: time.Date(2024, 4, 1, 8, 30, 0, 0, time.FixedZone("-0130", -90*60))},
+		Summary:   "init",
+		Boundary:  true,
+	}
+
+	if !reflect.DeepEqual(first, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", first, expected)
+	}
+
+	if lines[1].Commit != first || lines[3].Commit != first {
+		t.Fatal("the commit metadata must be shared between lines")
+	}
+
+	expectedLines := []Line{
+		{Commit: first, OriginalLine: 1, FinalLine: 1, Filename: "old.txt", Content: "keep"},
+		{Commit: first, OriginalLine: 2, FinalLine: 2, Filename: "old.txt", Content: "me"},
+		{
+			Commit: lines[2].Commit, OriginalLine: 4, FinalLine: 3, Filename: "new.txt", Content: "thanks!",
+			Previous: "48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec", PreviousFilename: "tést.txt",
+		},
+		{Commit: first, OriginalLine: 5, FinalLine: 4, Filename: "old.txt", Content: "bye"},
+	}
+
+	if !reflect.DeepEqual(lines, expectedLines) {
+		t.Fatalf("Got: %+v, expected: %+v.", lines, expectedLines)
+	}
+
+	if lines[2].Commit.Summary != "rename" || lines[2].Commit.Boundary {
+		t.Fatalf("unexpected commit: %+v", lines[2].Commit)
+	}
+}
+
+func TestParsePorcelain_truncated(t *testing.T) {
+	var errs error
+
+	for _, err := range ParsePorcelain(strings.NewReader("48a2c2f9de8c51e4a46fd0454fc6482c099cc1ec 1 1 1\nauthor John\n")) {
+		if err != nil {
+			errs = err
+			break
+		}
+	}
+
+	if errs == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestLines(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "one\ntwo\nthree\n")
+	repo.Git("add", ".")
+	repo.GitAs("alice", "alice@example.com", "commit", "-q", "-m", "init")
+	repo.WriteFile("a.txt", "one\n  two\nthree\nfour\n")
+	repo.GitAs("bob", "bob@example.com", "commit", "-q", "-a", "-m", "update")
+
+	lines := collect(t, Lines(context.Background(), "a.txt", global.UpperC(repo.Dir), IgnoreWhitespace, LineRange("2,4")))
+
+	if len(lines) != 3 {
+		t.Fatalf("Got: %d lines, expected: 3.", len(lines))
+	}
+
+	authors := []string{lines[0].Commit.Author.Email, lines[1].Commit.Author.Email, lines[2].Commit.Author.Email}
+	if !reflect.DeepEqual(authors, []string{"alice@example.com", "alice@example.com", "bob@example.com"}) {
+		t.Fatalf("unexpected authors: %v", authors)
+	}
+
+	if lines[0].Content != "  two" || lines[0].FinalLine != 2 || lines[2].Commit.Summary != "update" || lines[2].Previous == "" {
+		t.Fatalf("unexpected lines: %+v", lines)
+	}
+}
+
+func collect(t *testing.T, seq func(yield func(Line, error) bool)) []Line {
+	t.Helper()
+
+	var lines []Line
+
+	for line, err := range seq {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
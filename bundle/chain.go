@@ -0,0 +1,129 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/revlist"
+	"github.com/kumose-go/xgit/types"
+)
+
+// ErrEmpty There is no new commit to bundle.
+var ErrEmpty = errors.New("empty bundle")
+
+// CreateIncremental Creates a bundle with the commits of the rev-list arguments that are not in the previous bundles:
+// the tips of the previous bundles become the prerequisites of the new one.
+// Returns ErrEmpty (and creates no file) if there is no new commit.
+func CreateIncremental(ctx context.Context, file string, previous []string, revListArgs []types.Option, options ...types.Option) error {
+	var exclusions []string
+
+	for _, prev := range previous {
+		refs, err := Heads(ctx, prev, nil, types.GlobalOptions(options...))
+		if err != nil {
+			return err
+		}
+
+		for _, ref := range refs {
+			if !slices.Contains(exclusions, "^"+ref.OID) {
+				exclusions = append(exclusions, "^"+ref.OID)
+			}
+		}
+	}
+
+	args := slices.Concat(revListArgs, []types.Option{revlist.Revisions(exclusions...)})
+
+	g := types.NewCmd("rev-list")
+	g.ApplyOptions(types.GlobalOptions(options...), revlist.Count)
+	g.ApplyOptions(args...)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(output) == "0" {
+		return ErrEmpty
+	}
+
+	g = types.NewCmd("bundle")
+	g.ApplyOptions(Create(file, args, options...))
+
+	_, err = g.Output(ctx)
+
+	return err
+}
+
+// Chain An incremental bundle chain of a mirror, stored in a directory (`000001.bundle`, `000002.bundle`...):
+// the first bundle holds the whole history, each next bundle the commits added since the previous ones.
+// On the other side, the bundles are applied in order (ex: `git fetch <file> 'refs/*:refs/*'`).
+type Chain struct {
+	Dir string
+}
+
+// Files Returns the absolute paths of the bundles of the chain, in order.
+func (c Chain) Files() ([]string, error) {
+	dir, err := filepath.Abs(c.Dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.bundle"))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+// Append Creates the next bundle of the chain, from the rev-list arguments (revlist.All if empty), and returns its path.
+// Returns ErrEmpty (and creates no file) if there is no new commit.
+// The options are the create options and the global options.
+func (c Chain) Append(ctx context.Context, revListArgs []types.Option, options ...types.Option) (string, error) {
+	files, err := c.Files()
+	if err != nil {
+		return "", err
+	}
+
+	next := 1
+
+	if len(files) > 0 {
+		last := strings.TrimSuffix(filepath.Base(files[len(files)-1]), ".bundle")
+
+		n, errConv := strconv.Atoi(last)
+		if errConv != nil {
+			return "", fmt.Errorf("unexpected bundle in the chain: %s", files[len(files)-1])
+		}
+
+		next = n + 1
+	}
+
+	if len(revListArgs) == 0 {
+		revListArgs = []types.Option{revlist.All}
+	}
+
+	err = os.MkdirAll(c.Dir, 0o755)
+	if err != nil {
+		return "", err
+	}
+
+	file, err := filepath.Abs(filepath.Join(c.Dir, fmt.Sprintf("%06d.bundle", next)))
+	if err != nil {
+		return "", err
+	}
+
+	err = CreateIncremental(ctx, file, files, revListArgs, options...)
+	if err != nil {
+		return "", err
+	}
+
+	return file, nil
+}
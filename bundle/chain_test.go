@@ -0,0 +1,122 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/clone"
+	"github.com/kumose-go/xgit/fetch"
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/revlist"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestParseVerify(t *testing.T) {
+	output := `The bundle contains these 2 refs:
+2222222222222222222222222222222222222222 refs/heads/main
+3333333333333333333333333333333333333333 refs/tags/v1.0
+The bundle requires this ref:
+1111111111111111111111111111111111111111 
+The bundle uses this hash algorithm: sha1
+`
+
+	info := ParseVerify(output)
+
+	expected := Info{
+		Refs: []Ref{
+			{OID: "2222222222222222222222222222222222222222", Name: "refs/heads/main"},
+			{OID: "3333333333333333333333333333333333333333", Name: "refs/tags/v1.0"},
+		},
+		Prerequisites: []string{"1111111111111111111111111111111111111111"},
+		HashAlgorithm: "sha1",
+	}
+
+	if !reflect.DeepEqual(info, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", info, expected)
+	}
+
+	_, err := ParseHeads("refs/heads/main\n")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestChain(t *testing.T) {
+	origin := gittest.NewRepo(t)
+	origin.Git("commit", "-q", "--allow-empty", "-m", "first")
+	origin.Git("tag", "v1.0")
+
+	dir := gittest.TempDir(t)
+	mirror := filepath.Join(dir, "mirror")
+
+	chain := Chain{Dir: filepath.Join(dir, "bundles")}
+
+	first, err := chain.Append(context.Background(), []types.Option{revlist.Revisions("main", "v1.0")}, global.UpperC(origin.Dir), Quiet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a bundle can be cloned.
+	g := types.NewCmd("clone")
+	g.ApplyOptions(clone.Mirror, clone.Quiet, clone.Repository(first), clone.Directory(mirror))
+
+	_, err = g.Output(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origin.Git("commit", "-q", "--allow-empty", "-m", "second")
+	tip := origin.Git("rev-parse", "HEAD")
+
+	second, err := chain.Append(context.Background(), nil, global.UpperC(origin.Dir), Quiet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := chain.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(files, []string{first, second}) || filepath.Base(second) != "000002.bundle" {
+		t.Fatalf("Got: %+v, expected: [%s %s].", files, first, second)
+	}
+
+	info, err := VerifyInfo(context.Background(), second, global.UpperC(mirror))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(info.Prerequisites) != 1 || !reflect.DeepEqual(info.Refs, []Ref{{OID: tip, Name: "refs/heads/main"}, {OID: tip, Name: "HEAD"}}) {
+		t.Fatalf("unexpected bundle: %+v", info)
+	}
+
+	// a bundle can be fetched.
+	g = types.NewCmd("fetch")
+	g.ApplyOptions(global.UpperC(mirror), fetch.Quiet, fetch.Remote(second), fetch.RefSpec("refs/heads/*:refs/heads/*"))
+
+	_, err = g.Output(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refs, err := Heads(context.Background(), second, []string{"refs/heads/main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head := gittest.Open(t, mirror).Git("rev-parse", "main")
+
+	if len(refs) != 1 || head != refs[0].OID {
+		t.Fatalf("Got: %s, expected: %+v.", head, refs)
+	}
+
+	_, err = chain.Append(context.Background(), nil, global.UpperC(origin.Dir), Quiet)
+	if !errors.Is(err, ErrEmpty) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrEmpty)
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Package bundle git-bundle - Move objects and refs by archive.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-bundle
+
+	git bundle create [-q | --quiet | --progress | --all-progress]
+			[--all-progress-implied]
+			[--version=<version>] <file> <git-rev-list-args>
+	git bundle verify [-q | --quiet] <file>
+	git bundle list-heads <file> [<refname>...]
+	git bundle unbundle [--progress] <file> [<refname>...]
+
+# DESCRIPTION
+
+Create, unpack, and manipulate "bundle" files.
+Bundles are used for the "offline" transfer of Git objects without an active "server" sitting on the other side of the network connection.
+
+They can be used to create both incremental and full backups of a repository, and to relay the state of the references in one repository to another.
+
+Git commands that fetch or otherwise "read" via protocols such as ssh:// and https:// can also operate on bundle files (ex: clone.Repository, fetch.Remote).
+*/
+package bundle
@@ -0,0 +1,107 @@
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+var expOID = regexp.MustCompile(`^[0-9a-f]{40,64}$`)
+
+// Ref A reference of a bundle.
+type Ref struct {
+	OID  string
+	Name string
+}
+
+// Info The contents of a bundle, as shown by `git bundle verify`.
+type Info struct {
+	// Refs The references defined in the bundle.
+	Refs []Ref
+	// Prerequisites The commits required by the bundle (empty for a complete history).
+	Prerequisites []string
+	// HashAlgorithm The hash algorithm of the objects (ex: `sha1`), empty if not reported.
+	HashAlgorithm string
+}
+
+// VerifyInfo Checks that a bundle is valid and will apply cleanly to the current repository, and returns its contents.
+// The command runs with `LC_ALL=C`, because the output is parsed.
+func VerifyInfo(ctx context.Context, file string, options ...types.Option) (Info, error) {
+	g := types.NewCmd("bundle")
+	g.ApplyOptions(Verify(file, options...))
+	g.Env = append(g.Env, "LC_ALL=C")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return Info{}, err
+	}
+
+	return ParseVerify(output), nil
+}
+
+// ParseVerify Parses the standard output of `git bundle verify` (in the C locale).
+// The unknown lines are ignored.
+func ParseVerify(output string) Info {
+	var (
+		info     Info
+		requires bool
+	)
+
+	for _, line := range strings.Split(output, "\n") {
+		oid, name, _ := strings.Cut(line, " ")
+
+		switch {
+		case line == "":
+		case expOID.MatchString(oid) && requires:
+			info.Prerequisites = append(info.Prerequisites, oid)
+		case expOID.MatchString(oid):
+			info.Refs = append(info.Refs, Ref{OID: oid, Name: name})
+		case strings.HasPrefix(line, "The bundle contains"):
+			requires = false
+		case strings.HasPrefix(line, "The bundle requires"):
+			requires = true
+		case strings.HasPrefix(line, "The bundle uses this hash algorithm: "):
+			info.HashAlgorithm = strings.TrimPrefix(line, "The bundle uses this hash algorithm: ")
+		}
+	}
+
+	return info
+}
+
+// Heads Returns the references of a bundle (all, or only the given ones), using `git bundle list-heads`.
+// The bundle is not verified: the command can run outside a repository.
+func Heads(ctx context.Context, file string, refNames []string, options ...types.Option) ([]Ref, error) {
+	g := types.NewCmd("bundle")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(ListHeads(file, refNames...))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseHeads(output)
+}
+
+// ParseHeads Parses the output of `git bundle list-heads` and `git bundle unbundle`: `<oid> <refname>`.
+func ParseHeads(output string) ([]Ref, error) {
+	var refs []Ref
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		oid, name, found := strings.Cut(line, " ")
+		if !found || !expOID.MatchString(oid) {
+			return nil, fmt.Errorf("invalid bundle head: %q", line)
+		}
+
+		refs = append(refs, Ref{OID: oid, Name: name})
+	}
+
+	return refs, nil
+}
@@ -0,0 +1,45 @@
+package bundle
+
+import "github.com/kumose-go/xgit/types"
+
+// Create a bundle file from rev-list arguments (ex: `revlist.All`, `revlist.Since("10.days.ago")`, `revlist.Revisions("v1.0..main")`).
+// usage: git bundle create [-q | --quiet | --progress | --all-progress] [--all-progress-implied] [--version=<version>] <file> <git-rev-list-args>
+func Create(file string, revListArgs []types.Option, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("create")
+		g.ApplyOptions(options...)
+		g.AddOptions(file)
+		g.ApplyOptions(revListArgs...)
+	}
+}
+
+// Verify Check that a bundle file is valid and will apply cleanly to the current repository.
+// usage: git bundle verify [-q | --quiet] <file>
+func Verify(file string, options ...types.Option) types.Option {
+	return subCommand("verify", file, nil, options)
+}
+
+// ListHeads List the references defined in a bundle (all, or only the given ones).
+// usage: git bundle list-heads <file> [<refname>...]
+func ListHeads(file string, refNames ...string) types.Option {
+	return subCommand("list-heads", file, refNames, nil)
+}
+
+// Unbundle Store the objects of a bundle in the repository, and print the references (all, or only the given ones).
+// The references are not updated: use `git fetch <file>` for that.
+// usage: git bundle unbundle [--progress] <file> [<refname>...]
+func Unbundle(file string, refNames []string, options ...types.Option) types.Option {
+	return subCommand("unbundle", file, refNames, options)
+}
+
+func subCommand(name, file string, refNames []string, options []types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(name)
+		g.ApplyOptions(options...)
+		g.AddOptions(file)
+
+		for _, refName := range refNames {
+			g.AddOptions(refName)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package catfile
+
+import "github.com/kumose-go/xgit/types"
+
+// Type <type>
+// Typically this matches the real type of <object> but asking for a type that can trivially be dereferenced
+// from the given <object> is also permitted.
+func Type(typ string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(typ)
+	}
+}
+
+// Object <object>
+// The name of the object to show.
+func Object(object string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(object)
+	}
+}
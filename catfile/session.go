@@ -0,0 +1,390 @@
+package catfile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Mode The batch mode of a Session.
+type Mode int
+
+// Batch modes.
+const (
+	// ModeBatchCommand `--batch-command`: object information and contents (default).
+	ModeBatchCommand Mode = iota
+	// ModeBatch `--batch`: the object information is always followed by the contents.
+	ModeBatch
+	// ModeBatchCheck `--batch-check`: object information only.
+	ModeBatchCheck
+)
+
+var (
+	// ErrClosed The session is closed.
+	ErrClosed = errors.New("cat-file session closed")
+	// ErrUnsupported The request is not supported by the mode of the session.
+	ErrUnsupported = errors.New("unsupported by the cat-file session mode")
+
+	errExited = errors.New("cat-file exited")
+)
+
+// Info The information of an object.
+type Info struct {
+	OID  string
+	Type string
+	Size int64
+}
+
+// MissingObjectError The requested object does not exist.
+type MissingObjectError struct {
+	Object string
+}
+
+func (e *MissingObjectError) Error() string {
+	return "object not found: " + e.Object
+}
+
+// ObjectError The requested object cannot be read (ambiguous name, symlink issue with FollowSymlinks...).
+type ObjectError struct {
+	Object string
+	// Reason The reason given by git (ex: `ambiguous`, `dangling`, `loop`, `notdir`).
+	Reason string
+}
+
+func (e *ObjectError) Error() string {
+	return fmt.Sprintf("object %s: %s", e.Object, e.Reason)
+}
+
+// Session A long-lived `git cat-file --batch*` process.
+// The requests are serialized, so a Session is safe for concurrent use.
+// The process is started on the first request, and restarted if it dies.
+type Session struct {
+	mode    Mode
+	options []types.Option
+
+	mu     sync.Mutex
+	proc   *process
+	closed bool
+}
+
+// NewSession Creates a session.
+// The options are applied to each `git cat-file` process (global options, executor, environment, Textconv, Filters...).
+// The executor must use the standard input and output of the command (types.Cmd.Stdin and types.Cmd.Stdout).
+func NewSession(mode Mode, options ...types.Option) *Session {
+	return &Session{mode: mode, options: options}
+}
+
+// Info Returns the information of an object.
+func (s *Session) Info(ctx context.Context, object string) (Info, error) {
+	var info Info
+
+	err := s.do(ctx, object, false, func(i Info, _ io.Reader) error {
+		info = i
+		return nil
+	})
+
+	return info, err
+}
+
+// Contents Returns the information and the contents of an object.
+// The contents are read in memory: use Stream for large objects.
+func (s *Session) Contents(ctx context.Context, object string) (Info, io.Reader, error) {
+	var (
+		info    Info
+		content []byte
+	)
+
+	err := s.do(ctx, object, true, func(i Info, r io.Reader) error {
+		info = i
+
+		var err error
+
+		content, err = io.ReadAll(r)
+
+		return err
+	})
+	if err != nil {
+		return Info{}, nil, err
+	}
+
+	return info, bytes.NewReader(content), nil
+}
+
+// Stream Calls fn with the information and a reader on the contents of an object.
+// The reader is only valid during the call, the other requests wait for its end.
+func (s *Session) Stream(ctx context.Context, object string, fn func(Info, io.Reader) error) error {
+	return s.do(ctx, object, true, fn)
+}
+
+// Close Stops the process.
+func (s *Session) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+
+	if s.proc == nil {
+		return nil
+	}
+
+	err := s.proc.stop()
+	s.proc = nil
+
+	return err
+}
+
+func (s *Session) do(ctx context.Context, object string, contents bool, fn func(Info, io.Reader) error) error {
+	if object == "" || strings.ContainsAny(object, "\n\x00") {
+		return fmt.Errorf("invalid object name: %q", object)
+	}
+
+	if contents && s.mode == ModeBatchCheck {
+		return ErrUnsupported
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := ctx.Err()
+		if err != nil {
+			return err
+		}
+
+		if s.proc == nil {
+			s.proc, err = start(s.mode, s.options)
+			if err != nil {
+				return err
+			}
+		}
+
+		var pErr *processError
+
+		hasContents := s.mode == ModeBatch || (s.mode == ModeBatchCommand && contents)
+
+		err = s.proc.request(ctx, s.requestLine(object, contents), object, hasContents, fn)
+		if !errors.As(err, &pErr) {
+			return err
+		}
+
+		// the process is unusable.
+		s.proc.kill()
+		s.proc = nil
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// the process died before answering: restart it once.
+		if !pErr.retryable || attempt > 0 {
+			return pErr.err
+		}
+	}
+}
+
+func (s *Session) requestLine(object string, contents bool) string {
+	if s.mode != ModeBatchCommand {
+		return object + "\n"
+	}
+
+	if contents {
+		return "contents " + object + "\n"
+	}
+
+	return "info " + object + "\n"
+}
+
+// processError an I/O error with the process: the protocol state is lost.
+type processError struct {
+	err error
+	// retryable true when nothing has been read for the request.
+	retryable bool
+}
+
+func (e *processError) Error() string {
+	return e.err.Error()
+}
+
+type process struct {
+	stdin  *os.File
+	stdout *bufio.Reader
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+}
+
+func start(mode Mode, options []types.Option) (*process, error) {
+	stdinR, stdinW, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+
+	stdoutR, stdoutW := io.Pipe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	g := types.NewCmd("cat-file")
+	g.ApplyOptions(options...)
+
+	switch mode {
+	case ModeBatch:
+		g.ApplyOptions(Batch(""))
+	case ModeBatchCheck:
+		g.ApplyOptions(BatchCheck(""))
+	default:
+		g.ApplyOptions(BatchCommand(""))
+	}
+
+	g.Stdin = stdinR
+	g.Stdout = stdoutW
+
+	p := &process{
+		stdin:  stdinW,
+		stdout: bufio.NewReader(stdoutR),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(p.done)
+
+		stderr, errExec := g.Exec(ctx, g.Base, g.Debug, slices.Concat(g.BaseOptions, g.Options)...)
+
+		p.err = errExited
+		if errExec != nil {
+			p.err = &types.Error{Err: errExec, Stderr: stderr}
+		}
+
+		_ = stdinR.Close()
+		_ = stdoutW.CloseWithError(p.err)
+	}()
+
+	return p, nil
+}
+
+// request sends a request and reads its response.
+// hasContents is true when the response includes the contents (`--batch`, `contents` command).
+func (p *process) request(ctx context.Context, line, object string, hasContents bool, fn func(Info, io.Reader) error) error {
+	stop := context.AfterFunc(ctx, p.kill)
+	defer stop()
+
+	_, err := io.WriteString(p.stdin, line)
+	if err != nil {
+		return &processError{err: err, retryable: true}
+	}
+
+	header, err := p.stdout.ReadString('\n')
+	if err != nil {
+		return &processError{err: err, retryable: header == ""}
+	}
+
+	info, err := parseHeader(strings.TrimSuffix(header, "\n"), object)
+
+	var objErr *ObjectError
+
+	switch {
+	case errors.As(err, &objErr) && objErr.Reason != "ambiguous":
+		// the symlink errors are followed by the requested name.
+		errDiscard := p.discard(info.Size)
+		if errDiscard != nil {
+			return errDiscard
+		}
+
+		return err
+
+	case err != nil:
+		return err
+
+	case !hasContents:
+		return fn(info, nil)
+	}
+
+	content := &io.LimitedReader{R: p.stdout, N: info.Size}
+
+	errFn := fn(info, content)
+
+	// keep the protocol in sync, even if fn did not read everything.
+	errDiscard := p.discard(content.N)
+	if errDiscard != nil {
+		return errDiscard
+	}
+
+	return errFn
+}
+
+// discard skips the remaining contents and the trailing line feed.
+func (p *process) discard(n int64) error {
+	_, err := io.CopyN(io.Discard, p.stdout, n+1)
+	if err != nil {
+		return &processError{err: err}
+	}
+
+	return nil
+}
+
+func (p *process) kill() {
+	p.cancel()
+	_ = p.stdin.Close()
+}
+
+// stop closes the standard input, and waits for the end of the process.
+func (p *process) stop() error {
+	_ = p.stdin.Close()
+
+	<-p.done
+
+	p.cancel()
+
+	if errors.Is(p.err, errExited) {
+		return nil
+	}
+
+	return p.err
+}
+
+// parseHeader parses `<oid> SP <type> SP <size>`, `<object> SP missing`, `<object> SP ambiguous`,
+// or `<reason> SP <size>` for the symlink errors (FollowSymlinks).
+func parseHeader(header, object string) (Info, error) {
+	fields := strings.Fields(header)
+
+	switch {
+	case strings.HasSuffix(header, " missing"):
+		return Info{}, &MissingObjectError{Object: object}
+
+	case strings.HasSuffix(header, " ambiguous"):
+		return Info{}, &ObjectError{Object: object, Reason: "ambiguous"}
+
+	case len(fields) == 2 && (fields[0] == "dangling" || fields[0] == "loop" || fields[0] == "notdir"):
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return Info{}, &processError{err: fmt.Errorf("invalid header: %q", header)}
+		}
+
+		return Info{Size: size}, &ObjectError{Object: object, Reason: fields[0]}
+
+	case len(fields) == 3:
+		size, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return Info{}, &processError{err: fmt.Errorf("invalid header: %q", header)}
+		}
+
+		return Info{OID: fields[0], Type: fields[1], Size: size}, nil
+
+	default:
+		return Info{}, &processError{err: fmt.Errorf("unexpected header: %q", header)}
+	}
+}
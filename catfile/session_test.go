@@ -0,0 +1,160 @@
+package catfile
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestSession(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "hello\nworld\n")
+
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "init")
+	blob := repo.Git("rev-parse", "HEAD:a.txt")
+
+	ctx := context.Background()
+
+	session := NewSession(ModeBatchCommand, global.UpperC(repo.Dir))
+	defer func() { _ = session.Close() }()
+
+	info, err := session.Info(ctx, "HEAD:a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Info{OID: blob, Type: "blob", Size: 12}
+	if info != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", info, expected)
+	}
+
+	_, r, err := session.Contents(ctx, blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, _ := io.ReadAll(r)
+	if string(content) != "hello\nworld\n" {
+		t.Fatalf("Got: %q, expected: %q.", content, "hello\nworld\n")
+	}
+
+	// partial read: the session must stay usable.
+	err = session.Stream(ctx, blob, func(_ Info, r io.Reader) error {
+		line, errRead := bufio.NewReader(io.LimitReader(r, 6)).ReadString('\n')
+		if line != "hello\n" {
+			t.Errorf("Got: %q, expected: %q.", line, "hello\n")
+		}
+
+		return errRead
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var missing *MissingObjectError
+
+	_, err = session.Info(ctx, "HEAD:unknown.txt")
+	if !errors.As(err, &missing) {
+		t.Fatalf("Got: %v, expected a MissingObjectError.", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			i, errInfo := session.Info(ctx, "HEAD")
+			if errInfo != nil {
+				t.Error(errInfo)
+				return
+			}
+
+			if i.Type != "commit" {
+				t.Errorf("Got: %s, expected: commit.", i.Type)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	err = session.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = session.Info(ctx, "HEAD")
+	if !errors.Is(err, ErrClosed) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrClosed)
+	}
+
+	check := NewSession(ModeBatchCheck, global.UpperC(repo.Dir))
+	defer func() { _ = check.Close() }()
+
+	info, err = check.Info(ctx, "HEAD:a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", info, expected)
+	}
+
+	_, _, err = check.Contents(ctx, "HEAD:a.txt")
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrUnsupported)
+	}
+}
+
+// oneShotExecutor answers a single request, then exits.
+func oneShotExecutor(starts *int) types.Option {
+	return func(g *types.Cmd) {
+		g.Executor = func(_ context.Context, _ string, _ bool, _ ...string) (string, error) {
+			*starts++
+
+			line, err := bufio.NewReader(g.Stdin).ReadString('\n')
+			if err != nil {
+				return "", nil
+			}
+
+			_, err = io.WriteString(g.Stdout, strings.TrimPrefix(strings.TrimSpace(line), "info ")+" blob 3\n")
+
+			return "", err
+		}
+	}
+}
+
+func TestSession_restart(t *testing.T) {
+	var starts int
+
+	session := NewSession(ModeBatchCommand, oneShotExecutor(&starts))
+	defer func() { _ = session.Close() }()
+
+	for _, object := range []string{"a", "b"} {
+		info, err := session.Info(context.Background(), object)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := Info{OID: object, Type: "blob", Size: 3}
+		if info != expected {
+			t.Fatalf("Got: %+v, expected: %+v.", info, expected)
+		}
+	}
+
+	if starts != 2 {
+		t.Fatalf("Got: %d starts, expected: 2.", starts)
+	}
+}
@@ -0,0 +1,13 @@
+package cherrypick
+
+import "github.com/kumose-go/xgit/types"
+
+// Commits <commit>...
+// Commits to cherry-pick (ex: `main~4`, `main..next`, `^HEAD topic`).
+func Commits(commits ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, commit := range commits {
+			g.AddOptions(commit)
+		}
+	}
+}
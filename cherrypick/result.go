@@ -0,0 +1,79 @@
+package cherrypick
+
+import (
+	"context"
+
+	"github.com/kumose-go/xgit/internal/sequencer"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Status The outcome of a cherry-pick.
+type Status int
+
+// Statuses of a cherry-pick.
+const (
+	// StatusCompleted All the commits have been applied.
+	StatusCompleted Status = iota
+	// StatusConflict The sequencer stopped on a conflict (possibly resolved by rerere): resolve it, then use ContinueInfo (or SkipInfo, Abort, Quit).
+	StatusConflict
+	// StatusEmpty The sequencer stopped on a commit that became empty: use SkipInfo (or AllowEmpty, KeepRedundantCommits).
+	StatusEmpty
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusCompleted:
+		return "completed"
+	case StatusConflict:
+		return "conflict"
+	case StatusEmpty:
+		return "empty"
+	default:
+		return "unknown"
+	}
+}
+
+// Result The result of a cherry-pick.
+type Result struct {
+	Status Status
+	// Commit The commit on which the sequencer stopped (CHERRY_PICK_HEAD), if known.
+	Commit string
+	// Conflicts The conflicted paths (empty when rerere resolved all the conflicts, see `rerere.autoUpdate`).
+	Conflicts []string
+}
+
+// PickInfo Applies the commits, using `git cherry-pick`.
+// A conflict or an empty commit is not an error: it's reported by the Result.
+func PickInfo(ctx context.Context, commits []string, options ...types.Option) (*Result, error) {
+	return run(ctx, Commits(commits...), options)
+}
+
+// ContinueInfo Continues the cherry-pick in progress after the resolution of the conflicts, using `git cherry-pick --continue`.
+// The sequencer can stop again on another commit.
+func ContinueInfo(ctx context.Context, options ...types.Option) (*Result, error) {
+	return run(ctx, Continue, options)
+}
+
+// SkipInfo Skips the current commit and continues with the rest of the sequence, using `git cherry-pick --skip`.
+// The sequencer can stop again on another commit.
+func SkipInfo(ctx context.Context, options ...types.Option) (*Result, error) {
+	return run(ctx, Skip, options)
+}
+
+func run(ctx context.Context, action types.Option, options []types.Option) (*Result, error) {
+	stop, err := sequencer.Run(ctx, "cherry-pick", "CHERRY_PICK_HEAD", action, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if stop == nil {
+		return &Result{Status: StatusCompleted}, nil
+	}
+
+	result := &Result{Status: StatusConflict, Commit: stop.Commit, Conflicts: stop.Conflicts}
+	if stop.Empty {
+		result.Status = StatusEmpty
+	}
+
+	return result, nil
+}
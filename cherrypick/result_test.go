@@ -0,0 +1,144 @@
+package cherrypick
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestPickInfo(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "a\n")
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "init")
+
+	repo.Git("checkout", "-q", "-b", "feature")
+	repo.WriteFile("b.txt", "b\n")
+	repo.Git("add", "b.txt")
+	repo.Git("commit", "-q", "-m", "add b")
+	addB := repo.Git("rev-parse", "HEAD")
+	repo.WriteFile("a.txt", "feature\n")
+	repo.Git("commit", "-q", "-am", "change a")
+	changeA := repo.Git("rev-parse", "HEAD")
+
+	repo.Git("checkout", "-q", "main")
+	repo.WriteFile("a.txt", "main\n")
+	repo.Git("commit", "-q", "-am", "change a on main")
+
+	ctx := context.Background()
+	options := []types.Option{
+		global.UpperC(repo.Dir),
+		global.LowerC("user.name", "test"),
+		global.LowerC("user.email", "test@example.com"),
+		global.LowerC("core.editor", "true"),
+	}
+
+	result, err := PickInfo(ctx, []string{addB}, append(options, RecordOrigin)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusCompleted {
+		t.Fatalf("Got: %s, expected: %s.", result.Status, StatusCompleted)
+	}
+
+	if !strings.Contains(repo.Git("log", "-1", "--format=%B"), "(cherry picked from commit "+addB+")") {
+		t.Fatal("the origin must be recorded")
+	}
+
+	result, err = PickInfo(ctx, []string{changeA}, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Result{Status: StatusConflict, Commit: changeA, Conflicts: []string{"a.txt"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	// resolve the conflict with the current content: the commit becomes empty.
+	repo.Git("checkout", "--ours", "a.txt")
+	repo.Git("add", "a.txt")
+
+	result, err = ContinueInfo(ctx, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = &Result{Status: StatusEmpty, Commit: changeA}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	result, err = SkipInfo(ctx, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusCompleted {
+		t.Fatalf("Got: %s, expected: %s.", result.Status, StatusCompleted)
+	}
+
+	_, err = PickInfo(ctx, []string{"unknown"}, options...)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestPickInfo_rerere(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.Git("config", "rerere.enabled", "true")
+	repo.Git("config", "rerere.autoUpdate", "true")
+
+	repo.WriteFile("a.txt", "a\n")
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "init")
+
+	repo.Git("checkout", "-q", "-b", "feature")
+	repo.WriteFile("a.txt", "feature\n")
+	repo.Git("commit", "-q", "-am", "change a")
+	changeA := repo.Git("rev-parse", "HEAD")
+
+	repo.Git("checkout", "-q", "main")
+	repo.WriteFile("a.txt", "main\n")
+	repo.Git("commit", "-q", "-am", "change a on main")
+
+	ctx := context.Background()
+	options := []types.Option{
+		global.UpperC(repo.Dir),
+		global.LowerC("user.name", "test"),
+		global.LowerC("user.email", "test@example.com"),
+	}
+
+	// record a resolution.
+	result, err := PickInfo(ctx, []string{changeA}, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusConflict {
+		t.Fatalf("Got: %s, expected: %s.", result.Status, StatusConflict)
+	}
+
+	repo.WriteFile("a.txt", "resolved\n")
+	repo.Git("rerere")
+	repo.Git("cherry-pick", "--abort")
+
+	// rerere resolves the conflict: git stops without conflicted paths.
+	result, err = PickInfo(ctx, []string{changeA}, options...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Result{Status: StatusConflict, Commit: changeA}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+}
@@ -0,0 +1,16 @@
+package clean
+
+import "github.com/kumose-go/xgit/types"
+
+// PathSpecs [--] <pathspec>...
+// Limits the paths affected by the operation.
+// Must be the last option.
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
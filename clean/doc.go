@@ -0,0 +1,18 @@
+/*
+Package clean git-clean - Remove untracked files from the working tree.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-clean
+
+	git clean [-d] [-f] [-i] [-n] [-q] [-e <pattern>] [-x | -X] [--] [<pathspec>…​]
+
+# DESCRIPTION
+
+Cleans the working tree by recursively removing files that are not under version control, starting from the current directory.
+
+Normally, only files unknown to Git are removed, but if the -x option is specified, ignored files are also removed. This can, for example, be useful to remove all build products.
+
+If any optional <pathspec>... arguments are given, only those paths that match the pathspec are affected.
+*/
+package clean
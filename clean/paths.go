@@ -0,0 +1,62 @@
+package clean
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Parse Returns the removed paths from the output of `git clean` with LC_ALL=C (`Removing <path>`),
+// or the paths that would be removed with DryRun (`Would remove <path>`).
+// The directories end with a `/`. The skipped nested repositories are ignored.
+func Parse(output string) []string {
+	var paths []string
+
+	for _, line := range strings.Split(output, "\n") {
+		path, found := strings.CutPrefix(line, "Would remove ")
+		if !found {
+			path, found = strings.CutPrefix(line, "Removing ")
+		}
+
+		if !found || path == "" {
+			continue
+		}
+
+		paths = append(paths, unquote(path))
+	}
+
+	return paths
+}
+
+// Preview Returns the paths that would be removed, using `git clean --dry-run`.
+// The options select the paths (ex: Directories, NoIgnoreRules, Exclude, PathSpecs).
+// The command runs with LC_ALL=C: the messages are parsed untranslated.
+func Preview(ctx context.Context, options ...types.Option) ([]string, error) {
+	g := types.NewCmd("clean")
+	g.ApplyOptions(DryRun)
+	g.ApplyOptions(options...)
+	g.Env = append(g.Env, "LC_ALL=C")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(output), nil
+}
+
+// unquote decodes a C-quoted path.
+func unquote(path string) string {
+	if !strings.HasPrefix(path, `"`) {
+		return path
+	}
+
+	unquoted, err := strconv.Unquote(path)
+	if err != nil {
+		return path
+	}
+
+	return unquoted
+}
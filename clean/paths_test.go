@@ -0,0 +1,81 @@
+package clean
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestParse(t *testing.T) {
+	output := "Would remove build/\nWould remove \"tab\\there\"\nWould skip repository nested/\nWould remove \"\\303\\251t\\303\\251\"\n"
+
+	paths := Parse(output)
+
+	expected := []string{"build/", "tab\there", "été"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Got: %q, expected: %q.", paths, expected)
+	}
+
+	paths = Parse("Removing build/\nRemoving a.out\n")
+
+	expected = []string{"build/", "a.out"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Got: %q, expected: %q.", paths, expected)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	for _, name := range []string{".gitignore", "untracked.txt", "app.log", "build/out.bin"} {
+		content := ""
+		if name == ".gitignore" {
+			content = "*.log\n"
+		}
+
+		repo.WriteFile(name, content)
+	}
+
+	ctx := context.Background()
+
+	paths, err := Preview(ctx, global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{".gitignore", "untracked.txt"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Got: %q, expected: %q.", paths, expected)
+	}
+
+	// the messages are parsed in the C locale.
+	paths, err = Preview(ctx, global.UpperC(repo.Dir), gittest.Translated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Got: %q, expected: %q.", paths, expected)
+	}
+
+	paths, err = Preview(ctx, global.UpperC(repo.Dir), Directories, NoIgnoreRules, Exclude(".gitignore"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []string{"app.log", "build/", "untracked.txt"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Got: %q, expected: %q.", paths, expected)
+	}
+
+	// nothing is removed by the preview.
+	_, err = os.Stat(filepath.Join(repo.Dir, "build", "out.bin"))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -16,6 +16,7 @@ func Config(key, value string) types.Option {
 }
 
 // Repository The (possibly remote) repository to clone from. See the URLS section below for more information on specifying repositories.
+// A bundle file can also be used (see the bundle package).
 // <repository>
 func Repository(url string) types.Option {
 	return func(g *types.Cmd) {
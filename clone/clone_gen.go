@@ -8,6 +8,13 @@ import (
 	"github.com/kumose-go/xgit/types"
 )
 
+// AlsoFilterSubmodules Also apply the partial clone filter to any submodules in the repository.
+// Requires --filter and --recurse-submodules.
+// --also-filter-submodules
+func AlsoFilterSubmodules(g *types.Cmd) {
+	g.AddOptions("--also-filter-submodules")
+}
+
 // Bare Make a bare Git repository. That is, instead of creating <directory> and placing the administrative files in <directory>/.git, make the <directory> itself the $GIT_DIR. This obviously implies the -n because there is nowhere to check out the working tree. Also the branch heads at the remote are copied directly to corresponding local branch heads, without mapping them to refs/remotes/origin/. When this option is used, neither remote-tracking branches nor the related configuration variables are created.
 // --bare
 func Bare(g *types.Cmd) {
@@ -38,6 +45,17 @@ func Dissociate(g *types.Cmd) {
 	g.AddOptions("--dissociate")
 }
 
+// Filter Use the partial clone feature and request that the server sends a subset of reachable objects according to a given object filter.
+// When using --filter, the supplied <filter-spec> is used for the partial clone filter.
+// For example, --filter=blob:none will filter out all blobs (file contents) until needed by Git.
+// Also, --filter=blob:limit=<size> will filter out all blobs of size at least <size>.
+// --filter=<filter-spec>
+func Filter(filterSpec string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(fmt.Sprintf("--filter=%s", filterSpec))
+	}
+}
+
 // Jobs The number of submodules fetched at the same time. Defaults to the submodule.fetchJobs option.
 // -j <n>, --jobs <n>
 func Jobs(n string) types.Option {
@@ -164,6 +182,13 @@ func SingleBranch(g *types.Cmd) {
 	g.AddOptions("--single-branch")
 }
 
+// Sparse Employ a sparse-checkout, with only files in the toplevel directory initially being present.
+// The git-sparse-checkout(1) command can be used to grow the working directory as needed.
+// --sparse
+func Sparse(g *types.Cmd) {
+	g.AddOptions("--sparse")
+}
+
 // Template Specify the directory from which templates will be used; (See the 'TEMPLATE DIRECTORY' section of git-init(1).)
 // --template=<template_directory>
 func Template(templateDirectory string) types.Option {
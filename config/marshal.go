@@ -0,0 +1,456 @@
+package config
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+const tagName = "gitconfig"
+
+// exitCodeNothingToUnset exit code of `git config --unset-all` when the key does not exist.
+const exitCodeNothingToUnset = 5
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Setting A key with its values, as produced by Marshal.
+type Setting struct {
+	// Key The key relative to the marshaled section (ex: `timeout`, `foo.timeout`).
+	Key    string
+	Values []string
+	// Multi True if the key is multi-valued (slice field).
+	Multi bool
+}
+
+// Unmarshal Reads the values of a section (ex: `ourtool` or `ourtool.foo` for `[ourtool "foo"]`) into the struct pointed by v.
+//
+// The fields are mapped with the `gitconfig:"key"` tag, the other fields are ignored:
+//   - string, booleans (git spellings), integers (with k, m, g suffixes), time.Duration and encoding.TextUnmarshaler.
+//   - a time.Duration is written with a unit (ex: `1m30s`), a bare integer is a number of seconds (like the git timeouts).
+//   - a slice receives all the values of a multi-valued key.
+//   - a struct receives the subsection named by the tag (ex: `gitconfig:"foo"` for `[ourtool "foo"]`).
+//   - a map[string]T with the `gitconfig:"*"` tag receives all the subsections.
+//
+// The undefined keys leave the fields unchanged.
+func Unmarshal(s *Snapshot, section string, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unmarshal: a non-nil pointer to a struct is required, got %T", v)
+	}
+
+	return unmarshalStruct(s, section, rv.Elem())
+}
+
+// Marshal Returns the settings of the struct pointed by v (see Unmarshal for the mapping).
+// The `omitempty` tag option skips the zero values (ex: `gitconfig:"key,omitempty"`).
+func Marshal(v any) ([]Setting, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("marshal: a struct is required, got %T", v)
+	}
+
+	return marshalStruct("", rv)
+}
+
+// Save Writes the struct pointed by v into a section, with one `git config` call per value:
+// Entry for a single value, ReplaceAll and Add for a multi-valued key.
+// The options select the scope or the file to write (Global, Local, System, File...).
+func Save(ctx context.Context, section string, v any, options ...types.Option) error {
+	settings, err := Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	for _, setting := range settings {
+		key := section + "." + setting.Key
+
+		for _, option := range setting.Options(key) {
+			g := types.NewCmd("config")
+			g.ApplyOptions(options...)
+			g.ApplyOptions(option)
+
+			_, err = g.Output(ctx)
+			if err != nil && !(setting.Multi && len(setting.Values) == 0 && types.ExitCode(err) == exitCodeNothingToUnset) {
+				return fmt.Errorf("write %s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Options Returns one option per `git config` call needed to write the setting with the given key.
+func (s Setting) Options(key string) []types.Option {
+	if !s.Multi {
+		if len(s.Values) == 0 {
+			return nil
+		}
+
+		return []types.Option{Entry(key, s.Values[0])}
+	}
+
+	if len(s.Values) == 0 {
+		return []types.Option{UnsetAll(key, "")}
+	}
+
+	options := []types.Option{ReplaceAll(key, s.Values[0], "")}
+
+	for _, value := range s.Values[1:] {
+		options = append(options, Add(key, value))
+	}
+
+	return options
+}
+
+func unmarshalStruct(s *Snapshot, prefix string, rv reflect.Value) error {
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+
+		name, _, ok := parseTag(field)
+		if !ok {
+			continue
+		}
+
+		fv := rv.Field(i)
+		key := prefix + "." + name
+
+		switch {
+		case name == "*" && fv.Kind() == reflect.Map:
+			err := unmarshalMap(s, prefix, fv)
+			if err != nil {
+				return err
+			}
+
+		case isSubsection(fv):
+			err := unmarshalStruct(s, key, fv)
+			if err != nil {
+				return err
+			}
+
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8:
+			values := s.LookupAll(key)
+			if len(values) == 0 {
+				continue
+			}
+
+			slice := reflect.MakeSlice(fv.Type(), len(values), len(values))
+
+			for j, value := range values {
+				err := setValue(slice.Index(j), value)
+				if err != nil {
+					return fmt.Errorf("%s: %w", key, err)
+				}
+			}
+
+			fv.Set(slice)
+
+		default:
+			value, found := s.Lookup(key)
+			if !found {
+				continue
+			}
+
+			err := setValue(fv, value)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func unmarshalMap(s *Snapshot, prefix string, fv reflect.Value) error {
+	if fv.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("%s: map keys must be strings", prefix)
+	}
+
+	subsections := s.subsectionsOf(prefix)
+	if len(subsections) == 0 {
+		return nil
+	}
+
+	if fv.IsNil() {
+		fv.Set(reflect.MakeMap(fv.Type()))
+	}
+
+	elemType := fv.Type().Elem()
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("%s: map values must be structs", prefix)
+	}
+
+	for _, sub := range subsections {
+		mapKey := reflect.ValueOf(sub).Convert(fv.Type().Key())
+
+		elem := reflect.New(elemType).Elem()
+
+		if existing := fv.MapIndex(mapKey); existing.IsValid() {
+			elem.Set(existing)
+		}
+
+		err := unmarshalStruct(s, prefix+"."+sub, elem)
+		if err != nil {
+			return err
+		}
+
+		fv.SetMapIndex(mapKey, elem)
+	}
+
+	return nil
+}
+
+func setValue(fv reflect.Value, value Value) error {
+	if fv.CanAddr() && fv.Addr().Type().Implements(textUnmarshalerType) {
+		//nolint:forcetypeassert // checked by Implements.
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value.Value))
+	}
+
+	if fv.Type() == durationType {
+		d, err := parseDuration(value.Value)
+		if err != nil {
+			return err
+		}
+
+		fv.SetInt(int64(d))
+
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(value.Value)
+
+	case reflect.Bool:
+		if value.NoValue {
+			fv.SetBool(true)
+			return nil
+		}
+
+		b, err := ParseBool(value.Value)
+		if err != nil {
+			return err
+		}
+
+		fv.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := ParseInt(value.Value)
+		if err != nil {
+			return err
+		}
+
+		if fv.OverflowInt(i) {
+			return fmt.Errorf("value out of range: %q", value.Value)
+		}
+
+		fv.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := ParseInt(value.Value)
+		if err != nil {
+			return err
+		}
+
+		if i < 0 || fv.OverflowUint(uint64(i)) {
+			return fmt.Errorf("value out of range: %q", value.Value)
+		}
+
+		fv.SetUint(uint64(i))
+
+	default:
+		return fmt.Errorf("unsupported type: %s", fv.Type())
+	}
+
+	return nil
+}
+
+func marshalStruct(prefix string, rv reflect.Value) ([]Setting, error) {
+	var settings []Setting
+
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+
+		name, omitEmpty, ok := parseTag(field)
+		if !ok {
+			continue
+		}
+
+		fv := rv.Field(i)
+		key := joinKey(prefix, name)
+
+		switch {
+		case name == "*" && fv.Kind() == reflect.Map:
+			keys := fv.MapKeys()
+			sort.Slice(keys, func(a, b int) bool { return keys[a].String() < keys[b].String() })
+
+			for _, k := range keys {
+				elem := fv.MapIndex(k)
+				if elem.Kind() != reflect.Struct {
+					return nil, fmt.Errorf("%s: map values must be structs", key)
+				}
+
+				sub, err := marshalStruct(joinKey(prefix, k.String()), elem)
+				if err != nil {
+					return nil, err
+				}
+
+				settings = append(settings, sub...)
+			}
+
+		case isSubsection(fv):
+			sub, err := marshalStruct(key, fv)
+			if err != nil {
+				return nil, err
+			}
+
+			settings = append(settings, sub...)
+
+		case fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8:
+			if omitEmpty && fv.Len() == 0 {
+				continue
+			}
+
+			setting := Setting{Key: key, Multi: true}
+
+			for j := 0; j < fv.Len(); j++ {
+				value, err := formatValue(fv.Index(j))
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", key, err)
+				}
+
+				setting.Values = append(setting.Values, value)
+			}
+
+			settings = append(settings, setting)
+
+		default:
+			if omitEmpty && fv.IsZero() {
+				continue
+			}
+
+			value, err := formatValue(fv)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+
+			settings = append(settings, Setting{Key: key, Values: []string{value}})
+		}
+	}
+
+	return settings, nil
+}
+
+func formatValue(fv reflect.Value) (string, error) {
+	if fv.Type().Implements(textMarshalerType) {
+		//nolint:forcetypeassert // checked by Implements.
+		text, err := fv.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+
+	if fv.Type() == durationType {
+		return time.Duration(fv.Int()).String(), nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(fv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(fv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(fv.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported type: %s", fv.Type())
+	}
+}
+
+// subsectionsOf returns the subsections directly under a prefix (`section` or `section.subsection`).
+func (s *Snapshot) subsectionsOf(prefix string) []string {
+	section, parent, _ := strings.Cut(prefix, ".")
+
+	var subsections []string
+
+	seen := map[string]bool{}
+
+	for _, value := range s.values {
+		sec, sub, _, ok := SplitKey(value.Key)
+		if !ok || sub == "" || !strings.EqualFold(sec, section) {
+			continue
+		}
+
+		if parent != "" {
+			var found bool
+
+			sub, found = strings.CutPrefix(sub, parent+".")
+			if !found {
+				continue
+			}
+		}
+
+		if sub == "" || seen[sub] {
+			continue
+		}
+
+		seen[sub] = true
+
+		subsections = append(subsections, sub)
+	}
+
+	return subsections
+}
+
+func isSubsection(fv reflect.Value) bool {
+	return fv.Kind() == reflect.Struct && !reflect.PointerTo(fv.Type()).Implements(textUnmarshalerType)
+}
+
+func parseTag(field reflect.StructField) (name string, omitEmpty, ok bool) {
+	tag, found := field.Tag.Lookup(tagName)
+	if !found || tag == "-" || !field.IsExported() {
+		return "", false, false
+	}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+
+	return name, opts == "omitempty", true
+}
+
+func joinKey(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+// parseDuration parses a duration with a unit (ex: `1m30s`), or a bare integer as a number of seconds.
+func parseDuration(value string) (time.Duration, error) {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	return time.ParseDuration(value)
+}
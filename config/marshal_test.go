@@ -0,0 +1,174 @@
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+type remoteSettings struct {
+	URL   string   `gitconfig:"url"`
+	Fetch []string `gitconfig:"fetch"`
+}
+
+type toolSettings struct {
+	Name    string                    `gitconfig:"name"`
+	Enabled bool                      `gitconfig:"enabled"`
+	Verbose bool                      `gitconfig:"verbose"`
+	Jobs    int                       `gitconfig:"jobs"`
+	Limit   uint64                    `gitconfig:"limit,omitempty"`
+	Timeout time.Duration             `gitconfig:"timeout"`
+	Tags    []string                  `gitconfig:"tag"`
+	Foo     remoteSettings            `gitconfig:"foo"`
+	Remotes map[string]remoteSettings `gitconfig:"*"`
+	Ignored string
+}
+
+func TestUnmarshal(t *testing.T) {
+	snapshot := NewSnapshot([]Value{
+		{Key: "ourtool.name", Value: "bot"},
+		{Key: "ourtool.enabled", Value: "yes"},
+		{Key: "ourtool.verbose", NoValue: true},
+		{Key: "ourtool.jobs", Value: "2k"},
+		{Key: "ourtool.timeout", Value: "1m30s"},
+		{Key: "ourtool.tag", Value: "a"},
+		{Key: "ourtool.tag", Value: "b"},
+		{Key: "ourtool.foo.url", Value: "https://example.com/foo.git"},
+		{Key: "ourtool.foo.fetch", Value: "+refs/heads/*:refs/remotes/foo/*"},
+		{Key: "ourtool.bar.url", Value: "https://example.com/bar.git"},
+		{Key: "other.name", Value: "other"},
+	})
+
+	var settings toolSettings
+
+	err := Unmarshal(snapshot, "ourtool", &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := toolSettings{
+		Name:    "bot",
+		Enabled: true,
+		Verbose: true,
+		Jobs:    2048,
+		Timeout: 90 * time.Second,
+		Tags:    []string{"a", "b"},
+		Foo:     remoteSettings{URL: "https://example.com/foo.git", Fetch: []string{"+refs/heads/*:refs/remotes/foo/*"}},
+		Remotes: map[string]remoteSettings{
+			"foo": {URL: "https://example.com/foo.git", Fetch: []string{"+refs/heads/*:refs/remotes/foo/*"}},
+			"bar": {URL: "https://example.com/bar.git"},
+		},
+	}
+
+	if !reflect.DeepEqual(settings, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", settings, expected)
+	}
+
+	// a bare integer is a number of seconds.
+	err = Unmarshal(NewSnapshot([]Value{{Key: "ourtool.timeout", Value: "30"}}), "ourtool", &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if settings.Timeout != 30*time.Second {
+		t.Fatalf("Got: %s, expected: 30s.", settings.Timeout)
+	}
+}
+
+func TestUnmarshal_error(t *testing.T) {
+	snapshot := NewSnapshot([]Value{{Key: "ourtool.enabled", Value: "maybe"}})
+
+	var settings toolSettings
+
+	err := Unmarshal(snapshot, "ourtool", &settings)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	err = Unmarshal(snapshot, "ourtool", settings)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	settings := toolSettings{
+		Name:    "bot",
+		Enabled: true,
+		Timeout: 2 * time.Minute,
+		Tags:    []string{"a", "b"},
+		Foo:     remoteSettings{URL: "https://example.com/foo.git"},
+		Remotes: map[string]remoteSettings{
+			"z": {URL: "https://example.com/z.git"},
+			"a": {URL: "https://example.com/a.git", Fetch: []string{"x"}},
+		},
+	}
+
+	got, err := Marshal(&settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Setting{
+		{Key: "name", Values: []string{"bot"}},
+		{Key: "enabled", Values: []string{"true"}},
+		{Key: "verbose", Values: []string{"false"}},
+		{Key: "jobs", Values: []string{"0"}},
+		{Key: "timeout", Values: []string{"2m0s"}},
+		{Key: "tag", Values: []string{"a", "b"}, Multi: true},
+		{Key: "foo.url", Values: []string{"https://example.com/foo.git"}},
+		{Key: "foo.fetch", Multi: true},
+		{Key: "a.url", Values: []string{"https://example.com/a.git"}},
+		{Key: "a.fetch", Values: []string{"x"}, Multi: true},
+		{Key: "z.url", Values: []string{"https://example.com/z.git"}},
+		{Key: "z.fetch", Multi: true},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", got, expected)
+	}
+}
+
+func TestSave(t *testing.T) {
+	file := filepath.Join(gittest.TempDir(t), "config")
+
+	err := os.WriteFile(file, []byte("[ourtool]\n\ttag = old1\n\ttag = old2\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	settings := toolSettings{
+		Name:    "bot",
+		Jobs:    4,
+		Timeout: time.Second,
+		Tags:    []string{"a", "b"},
+		Foo:     remoteSettings{URL: "https://example.com/foo.git"},
+		Remotes: map[string]remoteSettings{"foo": {URL: "https://example.com/foo.git"}},
+	}
+
+	err = Save(context.Background(), "ourtool", &settings, File(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := Load(context.Background(), File(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got toolSettings
+
+	err = Unmarshal(snapshot, "ourtool", &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, settings) {
+		t.Fatalf("Got: %+v, expected: %+v.", got, settings)
+	}
+}
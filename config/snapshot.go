@@ -0,0 +1,536 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/types"
+)
+
+// ErrNotFound The key is not defined.
+var ErrNotFound = errors.New("key not found")
+
+// ErrRuntimePrefix The path is relative to the runtime prefix of git (`%(prefix)/`), which is only known by git: use ResolvePath.
+var ErrRuntimePrefix = errors.New("the runtime prefix of git is unknown")
+
+// prefixPath the prefix of the paths relative to the runtime prefix of git.
+const prefixPath = "%(prefix)/"
+
+// Scopes of a configuration value (`--show-scope`).
+const (
+	ScopeSystem   = "system"
+	ScopeGlobal   = "global"
+	ScopeLocal    = "local"
+	ScopeWorktree = "worktree"
+	ScopeCommand  = "command"
+)
+
+// Value A configuration value with its origin.
+type Value struct {
+	// Key The canonical key: section and variable names are lowercased, the subsection is kept as is.
+	Key   string
+	Value string
+	// NoValue True when the variable has no value (`[section] key`), which means true for a boolean.
+	NoValue bool
+	// Scope The scope of the value: system, global, local, worktree or command.
+	Scope string
+	// OriginType The type of the origin: file, blob, standard input or command line.
+	OriginType string
+	// Origin The file or blob where the value is defined.
+	Origin string
+}
+
+// Snapshot All the configuration values, loaded with a single `git config --list` call.
+type Snapshot struct {
+	values []Value
+	index  map[string][]int
+}
+
+// Load Loads the configuration values with `git config --list -z --show-origin --show-scope`.
+// The file options (Global, Local, System, File, Blob) restrict the loaded values.
+func Load(ctx context.Context, options ...types.Option) (*Snapshot, error) {
+	g := types.NewCmd("config")
+	g.AddOptions("--list")
+	g.AddOptions("--null")
+	g.AddOptions("--show-origin")
+	g.AddOptions("--show-scope")
+	g.ApplyOptions(options...)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseList(output)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSnapshot(values), nil
+}
+
+// NewSnapshot Creates a snapshot from values, in order of precedence (the last value wins).
+func NewSnapshot(values []Value) *Snapshot {
+	s := &Snapshot{values: values, index: map[string][]int{}}
+
+	for i, value := range values {
+		key := CanonicalKey(value.Key)
+		s.index[key] = append(s.index[key], i)
+	}
+
+	return s
+}
+
+// Values Returns all the values, in order of precedence.
+func (s *Snapshot) Values() []Value {
+	return s.values
+}
+
+// Scope Returns a snapshot restricted to the values of a scope.
+func (s *Snapshot) Scope(scope string) *Snapshot {
+	var values []Value
+
+	for _, value := range s.values {
+		if value.Scope == scope {
+			values = append(values, value)
+		}
+	}
+
+	return NewSnapshot(values)
+}
+
+// Has Returns true if the key is defined.
+func (s *Snapshot) Has(key string) bool {
+	return len(s.index[CanonicalKey(key)]) > 0
+}
+
+// Lookup Returns the value of a key: the last one if the key is multi-valued.
+func (s *Snapshot) Lookup(key string) (Value, bool) {
+	indexes := s.index[CanonicalKey(key)]
+	if len(indexes) == 0 {
+		return Value{}, false
+	}
+
+	return s.values[indexes[len(indexes)-1]], true
+}
+
+// LookupAll Returns all the values of a multi-valued key.
+func (s *Snapshot) LookupAll(key string) []Value {
+	var values []Value
+
+	for _, i := range s.index[CanonicalKey(key)] {
+		values = append(values, s.values[i])
+	}
+
+	return values
+}
+
+// Get Returns the raw value of a key, or an empty string if the key is not defined.
+func (s *Snapshot) Get(key string) string {
+	value, _ := s.Lookup(key)
+
+	return value.Value
+}
+
+// GetAll Returns the raw values of a multi-valued key.
+func (s *Snapshot) GetAll(key string) []string {
+	var values []string
+
+	for _, value := range s.LookupAll(key) {
+		values = append(values, value.Value)
+	}
+
+	return values
+}
+
+// Bool Returns the value of a key as a boolean (true/yes/on/1, false/no/off/0, or no value for true).
+func (s *Snapshot) Bool(key string) (bool, error) {
+	value, ok := s.Lookup(key)
+	if !ok {
+		return false, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+
+	if value.NoValue {
+		return true, nil
+	}
+
+	return ParseBool(value.Value)
+}
+
+// Int Returns the value of a key as an integer, with an optional k, m or g suffix.
+func (s *Snapshot) Int(key string) (int64, error) {
+	value, ok := s.Lookup(key)
+	if !ok {
+		return 0, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+
+	return ParseInt(value.Value)
+}
+
+// Path Returns the value of a key as a path, with `~` and `~user` expanded (see ExpandPath).
+func (s *Snapshot) Path(key string) (string, error) {
+	value, ok := s.Lookup(key)
+	if !ok {
+		return "", fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+
+	return ExpandPath(value.Value)
+}
+
+// Color Returns the value of a key as a color.
+func (s *Snapshot) Color(key string) (Color, error) {
+	value, ok := s.Lookup(key)
+	if !ok {
+		return Color{}, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+
+	return ParseColor(value.Value)
+}
+
+// Expiry Returns the value of a key as an expiry date (ex: `2.weeks.ago`, `never`, `now`).
+// `never` and `false` return a zero time.
+func (s *Snapshot) Expiry(key string) (time.Time, error) {
+	value, ok := s.Lookup(key)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+
+	return ParseExpiry(value.Value, time.Now())
+}
+
+// Subsections Returns the subsections of a section, in order of appearance.
+func (s *Snapshot) Subsections(section string) []string {
+	var subsections []string
+
+	seen := map[string]bool{}
+
+	for _, value := range s.values {
+		sec, sub, _, ok := SplitKey(value.Key)
+		if !ok || sub == "" || !strings.EqualFold(sec, section) || seen[sub] {
+			continue
+		}
+
+		seen[sub] = true
+
+		subsections = append(subsections, sub)
+	}
+
+	return subsections
+}
+
+// Variables Returns the variable names of a section (or subsection if not empty), in order of appearance.
+func (s *Snapshot) Variables(section, subsection string) []string {
+	var names []string
+
+	seen := map[string]bool{}
+
+	for _, value := range s.values {
+		sec, sub, name, ok := SplitKey(value.Key)
+		if !ok || sub != subsection || !strings.EqualFold(sec, section) || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// SplitKey Splits a key into section, subsection (optional) and variable name.
+func SplitKey(key string) (section, subsection, name string, ok bool) {
+	first := strings.Index(key, ".")
+	last := strings.LastIndex(key, ".")
+
+	if first < 0 {
+		return "", "", "", false
+	}
+
+	section = key[:first]
+	name = key[last+1:]
+
+	if first != last {
+		subsection = key[first+1 : last]
+	}
+
+	return section, subsection, name, section != "" && name != ""
+}
+
+// CanonicalKey Returns the canonical form of a key: section and variable names are case-insensitive.
+func CanonicalKey(key string) string {
+	section, subsection, name, ok := SplitKey(key)
+	if !ok {
+		return strings.ToLower(key)
+	}
+
+	if subsection == "" {
+		return strings.ToLower(section) + "." + strings.ToLower(name)
+	}
+
+	return strings.ToLower(section) + "." + subsection + "." + strings.ToLower(name)
+}
+
+// ParseBool Parses a boolean the same way as git.
+func ParseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "true", "yes", "on", "1":
+		return true, nil
+	case "false", "no", "off", "0", "":
+		return false, nil
+	}
+
+	i, err := ParseInt(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid boolean: %q", value)
+	}
+
+	return i != 0, nil
+}
+
+// ParseInt Parses an integer with an optional unit suffix: k (1024), m (1024²) or g (1024³).
+// Like git, a value out of the int64 range is an error (ex: `9999999999g`).
+func ParseInt(value string) (int64, error) {
+	v := strings.TrimSpace(value)
+	if v == "" {
+		return 0, fmt.Errorf("invalid integer: %q", value)
+	}
+
+	factor := int64(1)
+
+	switch v[len(v)-1] {
+	case 'k', 'K':
+		factor = 1 << 10
+	case 'm', 'M':
+		factor = 1 << 20
+	case 'g', 'G':
+		factor = 1 << 30
+	}
+
+	if factor != 1 {
+		v = v[:len(v)-1]
+	}
+
+	i, err := strconv.ParseInt(v, 0, 64)
+	if errors.Is(err, strconv.ErrRange) || i > math.MaxInt64/factor || i < math.MinInt64/factor {
+		return 0, fmt.Errorf("integer out of range: %q", value)
+	}
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer: %q", value)
+	}
+
+	return i * factor, nil
+}
+
+// ExpandPath Expands a leading `~/` or `~user/` like git.
+// A path relative to the runtime prefix of git (`%(prefix)/`) cannot be expanded without git: ErrRuntimePrefix is returned, use ResolvePath.
+func ExpandPath(value string) (string, error) {
+	if strings.HasPrefix(value, prefixPath) {
+		return "", fmt.Errorf("%q: %w", value, ErrRuntimePrefix)
+	}
+
+	if !strings.HasPrefix(value, "~") {
+		return value, nil
+	}
+
+	name, rest, _ := strings.Cut(value[1:], "/")
+
+	var home string
+
+	if name == "" {
+		var err error
+
+		home, err = os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+	} else {
+		u, err := user.Lookup(name)
+		if err != nil {
+			return "", err
+		}
+
+		home = u.HomeDir
+	}
+
+	return filepath.Join(home, rest), nil
+}
+
+// ResolvePath Expands a path with git (`git config --type=path`): `~/`, `~user/` and `%(prefix)/` (the runtime prefix of git).
+func ResolvePath(ctx context.Context, value string, options ...types.Option) (string, error) {
+	g := types.NewCmd("config")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(global.LowerC("xgit.path", value))
+	g.AddOptions("--type=path")
+	g.AddOptions("xgit.path")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(output, "\n"), nil
+}
+
+// Color A parsed color value (ex: `bold red blue`, `#ff0000 ul`, `214`).
+type Color struct {
+	Foreground string
+	Background string
+	Attributes []string
+}
+
+var colorNames = map[string]bool{
+	"normal": true, "default": true, "black": true, "red": true, "green": true, "yellow": true,
+	"blue": true, "magenta": true, "cyan": true, "white": true,
+}
+
+var colorAttributes = map[string]bool{
+	"bold": true, "dim": true, "ul": true, "blink": true, "reverse": true, "italic": true, "strike": true,
+}
+
+// ParseColor Parses a color value the same way as git.
+func ParseColor(value string) (Color, error) {
+	var color Color
+
+	colors := 0
+
+	for _, word := range strings.Fields(value) {
+		w := strings.ToLower(word)
+
+		switch {
+		case isColor(w):
+			switch colors {
+			case 0:
+				color.Foreground = w
+			case 1:
+				color.Background = w
+			default:
+				return Color{}, fmt.Errorf("invalid color: %q", value)
+			}
+
+			colors++
+
+		case w == "reset" || isColorAttribute(w):
+			color.Attributes = append(color.Attributes, w)
+
+		default:
+			return Color{}, fmt.Errorf("invalid color: %q", value)
+		}
+	}
+
+	return color, nil
+}
+
+func isColor(w string) bool {
+	if colorNames[strings.TrimPrefix(w, "bright")] {
+		return true
+	}
+
+	if strings.HasPrefix(w, "#") && len(w) == 7 {
+		_, err := strconv.ParseUint(w[1:], 16, 32)
+		return err == nil
+	}
+
+	n, err := strconv.Atoi(w)
+
+	return err == nil && n >= -1 && n <= 255
+}
+
+func isColorAttribute(w string) bool {
+	attr := strings.TrimPrefix(strings.TrimPrefix(w, "no"), "-")
+
+	return colorAttributes[attr]
+}
+
+var expiryUnits = map[string]time.Duration{
+	"second": time.Second,
+	"minute": time.Minute,
+	"hour":   time.Hour,
+	"day":    24 * time.Hour,
+	"week":   7 * 24 * time.Hour,
+}
+
+// ParseExpiry Parses an expiry date relative to now.
+// Supports `never`, `false`, `now`, `all`, `<n>.<unit>.ago` (or `<n> <unit> ago`) and absolute dates (RFC 3339, `2006-01-02`, `2006-01-02 15:04:05`).
+// `never` and `false` return a zero time.
+func ParseExpiry(value string, now time.Time) (time.Time, error) {
+	v := strings.ToLower(strings.TrimSpace(value))
+
+	switch v {
+	case "never", "false":
+		return time.Time{}, nil
+	case "now", "all":
+		return now, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05 -0700", "2006-01-02 15:04:05", "2006-01-02"} {
+		t, err := time.ParseInLocation(layout, value, time.Local)
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	fields := strings.FieldsFunc(v, func(r rune) bool { return r == '.' || r == ' ' })
+	if len(fields) == 3 && fields[2] == "ago" {
+		n, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid expiry date: %q", value)
+		}
+
+		unit := strings.TrimSuffix(fields[1], "s")
+
+		switch unit {
+		case "month":
+			return now.AddDate(0, -n, 0), nil
+		case "year":
+			return now.AddDate(-n, 0, 0), nil
+		}
+
+		if d, ok := expiryUnits[unit]; ok {
+			return now.Add(-time.Duration(n) * d), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid expiry date: %q", value)
+}
+
+// parseList parses the output of `git config --list -z --show-origin --show-scope`:
+// `<scope> NUL <origin-type>:<origin> NUL <key> [LF <value>] NUL`.
+func parseList(output string) ([]Value, error) {
+	fields := strings.Split(output, "\x00")
+
+	if fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+
+	if len(fields)%3 != 0 {
+		return nil, fmt.Errorf("invalid config output: %d fields", len(fields))
+	}
+
+	values := make([]Value, 0, len(fields)/3)
+
+	for i := 0; i < len(fields); i += 3 {
+		value := Value{Scope: fields[i]}
+
+		value.OriginType, value.Origin, _ = strings.Cut(fields[i+1], ":")
+
+		key, v, found := strings.Cut(fields[i+2], "\n")
+		value.Key = key
+		value.Value = v
+		value.NoValue = !found
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
@@ -0,0 +1,222 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+const listOutput = "system\x00file:/etc/gitconfig\x00core.autocrlf\nfalse\x00" +
+	"global\x00file:/home/user/.gitconfig\x00user.name\nJohn Doe\x00" +
+	"local\x00file:.git/config\x00remote.origin.url\nhttps://example.com/repo.git\x00" +
+	"local\x00file:.git/config\x00remote.origin.fetch\n+refs/heads/*:refs/remotes/origin/*\x00" +
+	"local\x00file:.git/config\x00remote.origin.fetch\n+refs/tags/*:refs/tags/*\x00" +
+	"local\x00file:.git/config\x00remote.Upstream.url\nhttps://example.com/upstream.git\x00" +
+	"local\x00file:.git/config\x00core.bigfilethreshold\n512k\x00" +
+	"local\x00file:.git/config\x00core.autocrlf\ninput\x00" +
+	"local\x00file:.git/config\x00my.flag\x00" +
+	"command\x00command line:\x00color.diff.new\nbold green\x00"
+
+func TestLoad(t *testing.T) {
+	snapshot, err := Load(context.Background(), gittest.StdoutExecutor(listOutput))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, ok := snapshot.Lookup("core.autoCRLF")
+	if !ok {
+		t.Fatal("core.autocrlf not found")
+	}
+
+	expected := Value{Key: "core.autocrlf", Value: "input", Scope: ScopeLocal, OriginType: "file", Origin: ".git/config"}
+	if !reflect.DeepEqual(value, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", value, expected)
+	}
+
+	fetch := snapshot.GetAll("remote.origin.fetch")
+	if !reflect.DeepEqual(fetch, []string{"+refs/heads/*:refs/remotes/origin/*", "+refs/tags/*:refs/tags/*"}) {
+		t.Fatalf("unexpected multi-value: %v", fetch)
+	}
+
+	subsections := snapshot.Subsections("remote")
+	if !reflect.DeepEqual(subsections, []string{"origin", "Upstream"}) {
+		t.Fatalf("unexpected subsections: %v", subsections)
+	}
+
+	if snapshot.Has("remote.upstream.url") {
+		t.Fatal("subsections must be case sensitive")
+	}
+
+	flag, err := snapshot.Bool("my.flag")
+	if err != nil || !flag {
+		t.Fatalf("Got: %v %v, expected: true.", flag, err)
+	}
+
+	threshold, err := snapshot.Int("core.bigFileThreshold")
+	if err != nil || threshold != 512*1024 {
+		t.Fatalf("Got: %v %v, expected: %d.", threshold, err, 512*1024)
+	}
+
+	color, err := snapshot.Color("color.diff.new")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(color, Color{Foreground: "green", Attributes: []string{"bold"}}) {
+		t.Fatalf("unexpected color: %+v", color)
+	}
+
+	_, err = snapshot.Bool("missing.key")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrNotFound)
+	}
+
+	global := snapshot.Scope(ScopeGlobal)
+	if len(global.Values()) != 1 || global.Get("user.name") != "John Doe" {
+		t.Fatalf("unexpected global scope: %+v", global.Values())
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	testCases := map[string]bool{
+		"true": true, "Yes": true, "on": true, "1": true, "42": true,
+		"false": false, "NO": false, "off": false, "0": false, "": false,
+	}
+
+	for value, expected := range testCases {
+		b, err := ParseBool(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if b != expected {
+			t.Errorf("%q: Got: %v, expected: %v.", value, b, expected)
+		}
+	}
+
+	_, err := ParseBool("maybe")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestParseInt(t *testing.T) {
+	testCases := map[string]int64{
+		"10": 10, "-3": -3, "1k": 1024, "2M": 2 << 20, "1g": 1 << 30, "0x10": 16,
+	}
+
+	for value, expected := range testCases {
+		i, err := ParseInt(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if i != expected {
+			t.Errorf("%q: Got: %v, expected: %v.", value, i, expected)
+		}
+	}
+
+	_, err := ParseInt("12t")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	_, err = ParseInt("9999999999g")
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatalf("Got: %v, expected: out of range.", err)
+	}
+}
+
+func TestExpandPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := ExpandPath("~/foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if path != filepath.Join(home, "foo") {
+		t.Fatalf("Got: %s, expected: %s.", path, filepath.Join(home, "foo"))
+	}
+
+	_, err = ExpandPath("%(prefix)/share/foo")
+	if !errors.Is(err, ErrRuntimePrefix) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrRuntimePrefix)
+	}
+
+	path, err = ResolvePath(context.Background(), "%(prefix)/share/foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !filepath.IsAbs(path) || !strings.HasSuffix(path, "/share/foo") {
+		t.Fatalf("Got: %s, expected: <prefix>/share/foo.", path)
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected Color
+	}{
+		{value: "red", expected: Color{Foreground: "red"}},
+		{value: "bold red blue", expected: Color{Foreground: "red", Background: "blue", Attributes: []string{"bold"}}},
+		{value: "#ff0000 214 no-ul", expected: Color{Foreground: "#ff0000", Background: "214", Attributes: []string{"no-ul"}}},
+		{value: "brightgreen nobold", expected: Color{Foreground: "brightgreen", Attributes: []string{"nobold"}}},
+	}
+
+	for _, test := range testCases {
+		color, err := ParseColor(test.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(color, test.expected) {
+			t.Errorf("%q: Got: %+v, expected: %+v.", test.value, color, test.expected)
+		}
+	}
+
+	_, err := ParseColor("red blue green")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestParseExpiry(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]time.Time{
+		"never":       {},
+		"now":         now,
+		"2.weeks.ago": now.Add(-14 * 24 * time.Hour),
+		"90 days ago": now.Add(-90 * 24 * time.Hour),
+		"1.month.ago": now.AddDate(0, -1, 0),
+		"3.hours.ago": now.Add(-3 * time.Hour),
+	}
+
+	for value, expected := range testCases {
+		expiry, err := ParseExpiry(value, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !expiry.Equal(expected) {
+			t.Errorf("%q: Got: %v, expected: %v.", value, expiry, expected)
+		}
+	}
+
+	_, err := ParseExpiry("someday", now)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,14 @@
+/*
+Package countobjects git-count-objects - Count unpacked number of objects and their disk consumption.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-count-objects
+
+	git count-objects [-v] [-H | --human-readable]
+
+# DESCRIPTION
+
+This counts the number of unpacked object files and disk space consumed by them, to help you decide when it is a good time to repack.
+*/
+package countobjects
@@ -0,0 +1,91 @@
+package countobjects
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Stats The object statistics of `git count-objects -v`.
+// The sizes are in bytes.
+type Stats struct {
+	// Count The number of loose objects.
+	Count int64
+	// Size The disk space consumed by the loose objects.
+	Size int64
+	// InPack The number of in-pack objects.
+	InPack int64
+	// Packs The number of packs.
+	Packs int64
+	// SizePack The disk space consumed by the packs.
+	SizePack int64
+	// PrunePackable The number of loose objects that are also present in the packs.
+	PrunePackable int64
+	// Garbage The number of files in the object database that are neither valid loose objects nor valid packs.
+	Garbage int64
+	// SizeGarbage The disk space consumed by the garbage files.
+	SizeGarbage int64
+}
+
+// StatsInfo Returns the object statistics, using `git count-objects -v`.
+// The HumanReadable option is not supported.
+func StatsInfo(ctx context.Context, options ...types.Option) (Stats, error) {
+	g := types.NewCmd("count-objects")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Verbose)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	return Parse(output)
+}
+
+// Parse Parses the output of `git count-objects -v` (the sizes are converted from KiB to bytes).
+func Parse(output string) (Stats, error) {
+	var stats Stats
+
+	fields := map[string]*int64{
+		"count":          &stats.Count,
+		"size":           &stats.Size,
+		"in-pack":        &stats.InPack,
+		"packs":          &stats.Packs,
+		"size-pack":      &stats.SizePack,
+		"prune-packable": &stats.PrunePackable,
+		"garbage":        &stats.Garbage,
+		"size-garbage":   &stats.SizeGarbage,
+	}
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ": ")
+		if !found {
+			return Stats{}, fmt.Errorf("invalid count-objects line: %q", line)
+		}
+
+		field, ok := fields[key]
+		if !ok {
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return Stats{}, fmt.Errorf("invalid count-objects line: %q", line)
+		}
+
+		if strings.HasPrefix(key, "size") {
+			n *= 1024
+		}
+
+		*field = n
+	}
+
+	return stats, nil
+}
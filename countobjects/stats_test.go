@@ -0,0 +1,51 @@
+package countobjects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestParse(t *testing.T) {
+	output := `count: 6
+size: 24
+in-pack: 120
+packs: 2
+size-pack: 48
+prune-packable: 1
+garbage: 0
+size-garbage: 0
+`
+
+	stats, err := Parse(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Stats{Count: 6, Size: 24 * 1024, InPack: 120, Packs: 2, SizePack: 48 * 1024, PrunePackable: 1}
+	if stats != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", stats, expected)
+	}
+
+	_, err = Parse("count: many\n")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestStatsInfo(t *testing.T) {
+	repo := gittest.NewRepo(t)
+	repo.Git("commit", "-q", "--allow-empty", "-m", "init")
+
+	stats, err := StatsInfo(context.Background(), global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the commit and the empty tree.
+	if stats.Count != 2 || stats.Size == 0 || stats.Packs != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
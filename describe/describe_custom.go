@@ -0,0 +1,13 @@
+package describe
+
+import "github.com/kumose-go/xgit/types"
+
+// CommitIsh [<commit-ish>...]
+// Commit-ish object names to describe. Defaults to HEAD if omitted.
+func CommitIsh(commitIshes ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, commitIsh := range commitIshes {
+			g.AddOptions(commitIsh)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package describe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+const (
+	defaultDirtyMark  = "-dirty"
+	defaultBrokenMark = "-broken"
+)
+
+// ErrAbbrevZero Commit uses `--long`, which git rejects with `--abbrev=0`.
+var ErrAbbrevZero = errors.New("describe: Abbrev(\"0\") is not supported (--long is required)")
+
+var (
+	expLong = regexp.MustCompile(`^(.+)-(\d+)-g([0-9a-f]{4,})$`)
+	expHash = regexp.MustCompile(`^[0-9a-f]{4,}$`)
+)
+
+// Description A parsed `git describe` output: `<tag>-<distance>-g<hash>[-dirty]`.
+type Description struct {
+	// Tag The name of the ref (ex: `v1.2.0`, `heads/main` with All), empty when only the hash is known (Always).
+	Tag string
+	// Distance The number of commits on top of the tag.
+	Distance int
+	// Hash The abbreviated commit name, empty for an exact match without Long.
+	Hash string
+	// Dirty True when the working tree has local modifications (Dirty).
+	Dirty bool
+	// Broken True when git cannot determine the state of the working tree (Broken).
+	Broken bool
+}
+
+// Exact True when the described commit is the tagged commit.
+func (d Description) Exact() bool {
+	return d.Tag != "" && d.Distance == 0
+}
+
+// String Returns the long format of the description.
+func (d Description) String() string {
+	var b strings.Builder
+
+	switch {
+	case d.Tag == "":
+		b.WriteString(d.Hash)
+	case d.Hash == "":
+		b.WriteString(d.Tag)
+	default:
+		fmt.Fprintf(&b, "%s-%d-g%s", d.Tag, d.Distance, d.Hash)
+	}
+
+	if d.Dirty {
+		b.WriteString(defaultDirtyMark)
+	}
+
+	if d.Broken {
+		b.WriteString(defaultBrokenMark)
+	}
+
+	return b.String()
+}
+
+// Commit Describes a commit (HEAD or the working tree by default, or the commit given by CommitIsh),
+// using `git describe --long`.
+// Options changing the output format (Contains) are not supported,
+// Abbrev("0") is rejected with ErrAbbrevZero (git does not allow `--abbrev=0` with `--long`).
+func Commit(ctx context.Context, options ...types.Option) (Description, error) {
+	g := types.NewCmd("describe")
+	g.ApplyOptions(Long)
+	g.ApplyOptions(options...)
+
+	dirtyMark, brokenMark, abbrev := defaultDirtyMark, defaultBrokenMark, ""
+
+	for _, option := range g.Options {
+		if mark, found := strings.CutPrefix(option, "--dirty="); found {
+			dirtyMark = mark
+		}
+
+		if mark, found := strings.CutPrefix(option, "--broken="); found {
+			brokenMark = mark
+		}
+
+		if value, found := strings.CutPrefix(option, "--abbrev="); found {
+			abbrev = value
+		}
+	}
+
+	if abbrev == "0" {
+		return Description{}, ErrAbbrevZero
+	}
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return Description{}, err
+	}
+
+	// with `--long`, a tag is always followed by the distance and the hash: a single value is a hash (Always).
+	return parse(strings.TrimSpace(output), dirtyMark, brokenMark, true)
+}
+
+// Parse Parses the output of `git describe` with the default marks:
+// `<tag>-<distance>-g<hash>`, `<tag>` (exact match without Long) or `<hash>` (Always),
+// optionally followed by `-dirty` or `-broken`.
+// A value made of hexadecimal digits only is parsed as a hash.
+func Parse(value string) (Description, error) {
+	return parse(value, defaultDirtyMark, defaultBrokenMark, false)
+}
+
+// parse parses a description: with long, a value without distance is a hash, otherwise it's a hash when it's made of hexadecimal digits only.
+func parse(value, dirtyMark, brokenMark string, long bool) (Description, error) {
+	var d Description
+
+	if value == "" {
+		return d, fmt.Errorf("invalid description: %q", value)
+	}
+
+	if dirtyMark != "" {
+		value, d.Dirty = strings.CutSuffix(value, dirtyMark)
+	}
+
+	if !d.Dirty && brokenMark != "" {
+		value, d.Broken = strings.CutSuffix(value, brokenMark)
+	}
+
+	if match := expLong.FindStringSubmatch(value); match != nil {
+		distance, err := strconv.Atoi(match[2])
+		if err != nil {
+			return Description{}, fmt.Errorf("invalid description: %q", value)
+		}
+
+		d.Tag, d.Distance, d.Hash = match[1], distance, match[3]
+
+		return d, nil
+	}
+
+	if long || expHash.MatchString(value) {
+		d.Hash = value
+		return d, nil
+	}
+
+	d.Tag = value
+
+	return d, nil
+}
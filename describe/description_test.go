@@ -0,0 +1,118 @@
+package describe
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestParse(t *testing.T) {
+	testCases := map[string]Description{
+		"v1.2.0":                       {Tag: "v1.2.0"},
+		"v1.2.0-dirty":                 {Tag: "v1.2.0", Dirty: true},
+		"v1.2.0-0-g1a2b3c4":            {Tag: "v1.2.0", Hash: "1a2b3c4"},
+		"v1.2.0-14-g1a2b3c4":           {Tag: "v1.2.0", Distance: 14, Hash: "1a2b3c4"},
+		"v1.2.0-14-g1a2b3c4-dirty":     {Tag: "v1.2.0", Distance: 14, Hash: "1a2b3c4", Dirty: true},
+		"v1.2.0-14-g1a2b3c4-broken":    {Tag: "v1.2.0", Distance: 14, Hash: "1a2b3c4", Broken: true},
+		"release-2-rc-3-g1a2b3c4":      {Tag: "release-2-rc", Distance: 3, Hash: "1a2b3c4"},
+		"heads/main-1-g1a2b3c4":        {Tag: "heads/main", Distance: 1, Hash: "1a2b3c4"},
+		"1a2b3c4":                      {Hash: "1a2b3c4"},
+		"1a2b3c4-dirty":                {Hash: "1a2b3c4", Dirty: true},
+		"v1.2.0-14-g1a2b3c4d5e6f7a8b9": {Tag: "v1.2.0", Distance: 14, Hash: "1a2b3c4d5e6f7a8b9"},
+	}
+
+	for value, expected := range testCases {
+		d, err := Parse(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d != expected {
+			t.Errorf("%q: Got: %+v, expected: %+v.", value, d, expected)
+		}
+	}
+
+	_, err := Parse("")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestDescription_String(t *testing.T) {
+	for _, value := range []string{"v1.2.0", "v1.2.0-14-g1a2b3c4-dirty", "1a2b3c4"} {
+		d, err := Parse(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if d.String() != value {
+			t.Errorf("Got: %s, expected: %s.", d, value)
+		}
+	}
+}
+
+func TestCommit(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	ctx := context.Background()
+
+	repo.WriteFile("a.txt", "a")
+
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "init")
+
+	d, err := Commit(ctx, global.UpperC(repo.Dir), Always, Abbrev("10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Description{Hash: repo.Git("rev-parse", "--short=10", "HEAD")}
+	if d != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", d, expected)
+	}
+
+	repo.Git("tag", "-a", "-m", "release", "v1.0.0")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "second")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "third")
+
+	repo.WriteFile("a.txt", "b")
+
+	d, err = Commit(ctx, global.UpperC(repo.Dir), Tags, Dirty("-modified"), Abbrev("10"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = Description{Tag: "v1.0.0", Distance: 2, Hash: repo.Git("rev-parse", "--short=10", "HEAD"), Dirty: true}
+	if d != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", d, expected)
+	}
+
+	d, err = Commit(ctx, global.UpperC(repo.Dir), CommitIsh("v1.0.0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !d.Exact() || d.Tag != "v1.0.0" {
+		t.Fatalf("Got: %+v, expected an exact match of v1.0.0.", d)
+	}
+
+	// a tag made of hexadecimal digits only.
+	repo.Git("tag", "-a", "-m", "hex", "deadbeef")
+
+	d, err = Commit(ctx, global.UpperC(repo.Dir), Always)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !d.Exact() || d.Tag != "deadbeef" {
+		t.Fatalf("Got: %+v, expected an exact match of deadbeef.", d)
+	}
+
+	_, err = Commit(ctx, global.UpperC(repo.Dir), Abbrev("0"))
+	if !errors.Is(err, ErrAbbrevZero) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrAbbrevZero)
+	}
+}
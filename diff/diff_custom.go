@@ -0,0 +1,31 @@
+package diff
+
+import "github.com/kumose-go/xgit/types"
+
+// HyphenHyphen add `--`
+func HyphenHyphen(g *types.Cmd) {
+	g.AddOptions("--")
+}
+
+// Revisions [<commit>...]
+// The commits, ranges (ex: `main..topic`, `main...topic`) or blobs to compare.
+func Revisions(revisions ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, revision := range revisions {
+			g.AddOptions(revision)
+		}
+	}
+}
+
+// PathSpecs [--] <path>...
+// Limit the diff to the named paths.
+// Must be the last option.
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
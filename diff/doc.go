@@ -0,0 +1,19 @@
+/*
+Package diff git-diff - Show changes between commits, commit and working tree, etc.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-diff
+
+	git diff [<options>] [<commit>] [--] [<path>…​]
+	git diff [<options>] --cached [--merge-base] [<commit>] [--] [<path>…​]
+	git diff [<options>] [--merge-base] <commit> [<commit>…​] <commit> [--] [<path>…​]
+	git diff [<options>] <commit>…​<commit> [--] [<path>…​]
+	git diff [<options>] <blob> <blob>
+	git diff [<options>] --no-index [--] <path> <path>
+
+# DESCRIPTION
+
+Show changes between the working tree and the index or a tree, changes between the index and a tree, changes between two trees, changes resulting from a merge, changes between two blob objects, or changes between two files on disk.
+*/
+package diff
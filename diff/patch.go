@@ -0,0 +1,411 @@
+package diff
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Status The kind of change of a file (same letters as `--name-status`).
+type Status string
+
+// Statuses of a file.
+const (
+	StatusAdded    Status = "A"
+	StatusCopied   Status = "C"
+	StatusDeleted  Status = "D"
+	StatusModified Status = "M"
+	StatusRenamed  Status = "R"
+)
+
+// LineKind The kind of line of a hunk.
+type LineKind byte
+
+// Kinds of line.
+const (
+	LineContext LineKind = ' '
+	LineAdded   LineKind = '+'
+	LineDeleted LineKind = '-'
+)
+
+const devNull = "/dev/null"
+
+var expHunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+// File The changes of a file in a unified diff.
+type File struct {
+	// OldPath The path before the change (empty for an added file).
+	OldPath string
+	// NewPath The path after the change (empty for a deleted file).
+	NewPath string
+	OldMode string
+	NewMode string
+	// OldOID The abbreviated object name before the change, from the `index` line.
+	OldOID string
+	// NewOID The abbreviated object name after the change, from the `index` line.
+	NewOID string
+	Status Status
+	// Similarity The similarity index (percentage) of a rename or a copy.
+	Similarity int
+	// Binary True when the content is binary (`Binary files differ` or `GIT binary patch`).
+	Binary bool
+	Hunks  []Hunk
+}
+
+// Hunk A hunk of a unified diff (`@@ -<old start>,<old lines> +<new start>,<new lines> @@ <section>`).
+type Hunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	// Section The text after the hunk header (usually the enclosing function).
+	Section string
+	Lines   []Line
+}
+
+// Line A line of a hunk.
+type Line struct {
+	Kind    LineKind
+	Content string
+	// OldNumber The line number in the old file (0 for an added line).
+	OldNumber int
+	// NewNumber The line number in the new file (0 for a deleted line).
+	NewNumber int
+	// NoNewline True when the line is followed by `\ No newline at end of file`.
+	NoNewline bool
+}
+
+// Files Returns the changes parsed from the output of `git diff`.
+// Options changing the output format (Stat, NameStatus, WordDiff...) are not supported.
+func Files(ctx context.Context, options ...types.Option) ([]File, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	g := types.NewCmd("diff")
+	g.AddOptions("--no-color")
+	g.AddOptions("--no-ext-diff")
+	g.ApplyOptions(SrcPrefix("a/"), DstPrefix("b/"))
+	g.ApplyOptions(options...)
+
+	stdout := g.Pipe(ctx)
+	defer func() { _ = stdout.Close() }()
+
+	return Parse(stdout)
+}
+
+// Parse Parses a unified diff, as produced by `git diff`, `git log -p` or `git format-patch`.
+// The lines outside of the file diffs (ex: commit messages) are ignored.
+func Parse(r io.Reader) ([]File, error) {
+	p := &parser{r: bufio.NewReader(r)}
+
+	err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.files, nil
+}
+
+type parser struct {
+	r     *bufio.Reader
+	files []File
+	// current the file being parsed, nil outside of a file diff.
+	current *File
+}
+
+func (p *parser) parse() error {
+	for {
+		line, err := p.readLine()
+		if errors.Is(err, io.EOF) {
+			p.flush()
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			p.flush()
+
+			p.current = &File{Status: StatusModified}
+			p.current.OldPath, p.current.NewPath = parseGitPaths(strings.TrimPrefix(line, "diff --git "))
+
+		case strings.HasPrefix(line, "diff "):
+			// combined diffs (`diff --cc`) are not supported.
+			p.flush()
+
+		case strings.HasPrefix(line, "--- ") && (p.current == nil || len(p.current.Hunks) > 0):
+			// plain unified diff, without `diff --git` line.
+			p.flush()
+
+			p.current = &File{Status: StatusModified}
+			p.current.OldPath = parsePath(strings.TrimPrefix(line, "--- "), "a/")
+
+		case p.current == nil:
+			continue
+
+		case strings.HasPrefix(line, "@@ "):
+			err = p.parseHunk(line)
+			if err != nil {
+				return err
+			}
+
+		case strings.HasPrefix(line, `\`):
+			p.markNoNewline()
+
+		default:
+			p.parseHeader(line)
+		}
+	}
+}
+
+// parseHeader parses an extended header line.
+func (p *parser) parseHeader(line string) {
+	file := p.current
+
+	switch {
+	case strings.HasPrefix(line, "--- "):
+		file.OldPath = parsePath(strings.TrimPrefix(line, "--- "), "a/")
+
+	case strings.HasPrefix(line, "+++ "):
+		file.NewPath = parsePath(strings.TrimPrefix(line, "+++ "), "b/")
+
+	case strings.HasPrefix(line, "old mode "):
+		file.OldMode = strings.TrimPrefix(line, "old mode ")
+
+	case strings.HasPrefix(line, "new mode "):
+		file.NewMode = strings.TrimPrefix(line, "new mode ")
+
+	case strings.HasPrefix(line, "deleted file mode "):
+		file.Status = StatusDeleted
+		file.OldMode = strings.TrimPrefix(line, "deleted file mode ")
+		file.NewPath = ""
+
+	case strings.HasPrefix(line, "new file mode "):
+		file.Status = StatusAdded
+		file.NewMode = strings.TrimPrefix(line, "new file mode ")
+		file.OldPath = ""
+
+	case strings.HasPrefix(line, "similarity index "):
+		file.Similarity, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(line, "similarity index "), "%"))
+
+	case strings.HasPrefix(line, "rename from "):
+		file.Status = StatusRenamed
+		file.OldPath = parsePath(strings.TrimPrefix(line, "rename from "), "")
+
+	case strings.HasPrefix(line, "rename to "):
+		file.Status = StatusRenamed
+		file.NewPath = parsePath(strings.TrimPrefix(line, "rename to "), "")
+
+	case strings.HasPrefix(line, "copy from "):
+		file.Status = StatusCopied
+		file.OldPath = parsePath(strings.TrimPrefix(line, "copy from "), "")
+
+	case strings.HasPrefix(line, "copy to "):
+		file.Status = StatusCopied
+		file.NewPath = parsePath(strings.TrimPrefix(line, "copy to "), "")
+
+	case strings.HasPrefix(line, "index "):
+		// index <old>..<new> [<mode>]
+		oids, mode, _ := strings.Cut(strings.TrimPrefix(line, "index "), " ")
+		file.OldOID, file.NewOID, _ = strings.Cut(oids, "..")
+
+		if mode != "" {
+			file.OldMode, file.NewMode = mode, mode
+		}
+
+	case strings.HasPrefix(line, "Binary files "), line == "GIT binary patch":
+		file.Binary = true
+	}
+}
+
+// parseHunk parses a hunk header and its lines.
+func (p *parser) parseHunk(header string) error {
+	match := expHunkHeader.FindStringSubmatch(header)
+	if match == nil {
+		return fmt.Errorf("invalid hunk header: %q", header)
+	}
+
+	hunk := Hunk{
+		OldStart: atoi(match[1], 0),
+		OldLines: atoi(match[2], 1),
+		NewStart: atoi(match[3], 0),
+		NewLines: atoi(match[4], 1),
+		Section:  match[5],
+	}
+
+	oldNumber, newNumber := hunk.OldStart, hunk.NewStart
+	oldRemaining, newRemaining := hunk.OldLines, hunk.NewLines
+
+	for oldRemaining > 0 || newRemaining > 0 {
+		line, err := p.readLine()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("truncated hunk: %q", header)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if strings.HasPrefix(line, `\`) {
+			markNoNewline(hunk.Lines)
+			continue
+		}
+
+		kind := LineContext
+		if line != "" {
+			kind = LineKind(line[0])
+			line = line[1:]
+		}
+
+		switch kind {
+		case LineContext:
+			hunk.Lines = append(hunk.Lines, Line{Kind: kind, Content: line, OldNumber: oldNumber, NewNumber: newNumber})
+			oldNumber++
+			newNumber++
+			oldRemaining--
+			newRemaining--
+
+		case LineAdded:
+			hunk.Lines = append(hunk.Lines, Line{Kind: kind, Content: line, NewNumber: newNumber})
+			newNumber++
+			newRemaining--
+
+		case LineDeleted:
+			hunk.Lines = append(hunk.Lines, Line{Kind: kind, Content: line, OldNumber: oldNumber})
+			oldNumber++
+			oldRemaining--
+
+		default:
+			return fmt.Errorf("invalid hunk line: %q", line)
+		}
+	}
+
+	p.current.Hunks = append(p.current.Hunks, hunk)
+
+	return nil
+}
+
+// markNoNewline handles `\ No newline at end of file` after the last line of a hunk.
+func (p *parser) markNoNewline() {
+	if len(p.current.Hunks) == 0 {
+		return
+	}
+
+	markNoNewline(p.current.Hunks[len(p.current.Hunks)-1].Lines)
+}
+
+func (p *parser) flush() {
+	if p.current != nil {
+		p.files = append(p.files, *p.current)
+		p.current = nil
+	}
+}
+
+// readLine reads a line without its line feed.
+func (p *parser) readLine() (string, error) {
+	line, err := p.r.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", err
+	}
+
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+func markNoNewline(lines []Line) {
+	if len(lines) > 0 {
+		lines[len(lines)-1].NoNewline = true
+	}
+}
+
+// parseGitPaths parses the paths of a `diff --git a/<old> b/<new>` line.
+// The extended headers (`---`, `+++`, `rename from`...) take precedence over these paths.
+func parseGitPaths(paths string) (string, string) {
+	if strings.HasPrefix(paths, `"`) {
+		quoted, rest := cutQuoted(paths)
+		return parsePath(quoted, "a/"), parsePath(strings.TrimPrefix(rest, " "), "b/")
+	}
+
+	if strings.HasSuffix(paths, `"`) {
+		if i := strings.LastIndex(paths, ` "`); i >= 0 {
+			return parsePath(paths[:i], "a/"), parsePath(paths[i+1:], "b/")
+		}
+	}
+
+	// `a/<path> b/<path>`: both paths are the same, except for a rename or a copy.
+	if len(paths)%2 == 1 {
+		half := len(paths) / 2
+
+		oldPath, newPath := strings.TrimPrefix(paths[:half], "a/"), strings.TrimPrefix(paths[half+1:], "b/")
+		if paths[half] == ' ' && oldPath == newPath {
+			return oldPath, newPath
+		}
+	}
+
+	if i := strings.Index(paths, " b/"); i >= 0 {
+		return strings.TrimPrefix(paths[:i], "a/"), paths[i+3:]
+	}
+
+	return paths, paths
+}
+
+// parsePath parses a path, which is C-quoted when it contains special characters,
+// optionally followed by a TAB (and a timestamp for non-git diffs).
+func parsePath(raw, prefix string) string {
+	if raw == devNull || strings.HasPrefix(raw, devNull+"\t") {
+		return ""
+	}
+
+	var path string
+
+	if strings.HasPrefix(raw, `"`) {
+		quoted, _ := cutQuoted(raw)
+
+		unquoted, err := strconv.Unquote(quoted)
+		if err != nil {
+			unquoted = quoted
+		}
+
+		path = unquoted
+	} else {
+		path, _, _ = strings.Cut(raw, "\t")
+	}
+
+	return strings.TrimPrefix(path, prefix)
+}
+
+// cutQuoted returns the leading double-quoted string and the rest.
+func cutQuoted(s string) (string, string) {
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return s[:i+1], s[i+1:]
+		}
+	}
+
+	return s, ""
+}
+
+func atoi(value string, defaultValue int) int {
+	if value == "" {
+		return defaultValue
+	}
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+
+	return i
+}
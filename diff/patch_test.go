@@ -0,0 +1,190 @@
+package diff
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+const patch = `diff --git a/a.txt b/a.txt
+index 4cb29ea..a623a0b 100644
+--- a/a.txt
++++ b/a.txt
+@@ -1,3 +1,3 @@ func main() {
+ one
+-two
+-three
++2
++three
+\ No newline at end of file
+diff --git a/bin b/bin
+new file mode 100644
+index 0000000..bdc955b
+Binary files /dev/null and b/bin differ
+diff --git a/old.txt b/new.txt
+similarity index 65%
+rename from old.txt
+rename to new.txt
+index 2469e83..f344ce4 100644
+--- a/old.txt
++++ b/new.txt
+@@ -1,4 +1,4 @@
+ keep
+ me
+
+-thanks
++thanks!
+diff --git a/script.sh b/script.sh
+old mode 100644
+new mode 100755
+diff --git a/gone.txt b/sp ace
+similarity index 100%
+rename from gone.txt
+rename to sp ace
+diff --git a/sp ace b/sp ace
+deleted file mode 100644
+index 1b32298..0000000
+--- a/sp ace	
++++ /dev/null
+@@ -1 +0,0 @@
+--- x
+diff --git "a/t\303\251st" "b/t\303\251st"
+new file mode 100644
+index 0000000..a9074c7
+--- /dev/null
++++ "b/t\303\251st"
+@@ -0,0 +1 @@
++caf
+`
+
+func TestParse(t *testing.T) {
+	files, err := Parse(strings.NewReader(patch))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []File{
+		{
+			OldPath: "a.txt", NewPath: "a.txt", OldMode: "100644", NewMode: "100644", OldOID: "4cb29ea", NewOID: "a623a0b", Status: StatusModified,
+			Hunks: []Hunk{{
+				OldStart: 1, OldLines: 3, NewStart: 1, NewLines: 3, Section: "func main() {",
+				Lines: []Line{
+					{Kind: LineContext, Content: "one", OldNumber: 1, NewNumber: 1},
+					{Kind: LineDeleted, Content: "two", OldNumber: 2},
+					{Kind: LineDeleted, Content: "three", OldNumber: 3},
+					{Kind: LineAdded, Content: "2", NewNumber: 2},
+					{Kind: LineAdded, Content: "three", NewNumber: 3, NoNewline: true},
+				},
+			}},
+		},
+		{NewPath: "bin", NewMode: "100644", OldOID: "0000000", NewOID: "bdc955b", Status: StatusAdded, Binary: true},
+		{
+			OldPath: "old.txt", NewPath: "new.txt", OldMode: "100644", NewMode: "100644", OldOID: "2469e83", NewOID: "f344ce4", Status: StatusRenamed, Similarity: 65,
+			Hunks: []Hunk{{
+				OldStart: 1, OldLines: 4, NewStart: 1, NewLines: 4,
+				Lines: []Line{
+					{Kind: LineContext, Content: "keep", OldNumber: 1, NewNumber: 1},
+					{Kind: LineContext, Content: "me", OldNumber: 2, NewNumber: 2},
+					{Kind: LineContext, Content: "", OldNumber: 3, NewNumber: 3},
+					{Kind: LineDeleted, Content: "thanks", OldNumber: 4},
+					{Kind: LineAdded, Content: "thanks!", NewNumber: 4},
+				},
+			}},
+		},
+		{OldPath: "script.sh", NewPath: "script.sh", OldMode: "100644", NewMode: "100755", Status: StatusModified},
+		{OldPath: "gone.txt", NewPath: "sp ace", Status: StatusRenamed, Similarity: 100},
+		{
+			OldPath: "sp ace", OldMode: "100644", OldOID: "1b32298", NewOID: "0000000", Status: StatusDeleted,
+			Hunks: []Hunk{{
+				OldStart: 1, OldLines: 1, NewStart: 0, NewLines: 0,
+				Lines: []Line{{Kind: LineDeleted, Content: "-- x", OldNumber: 1}},
+			}},
+		},
+		{
+			NewPath: "tést", NewMode: "100644", OldOID: "0000000", NewOID: "a9074c7", Status: StatusAdded,
+			Hunks: []Hunk{{
+				OldStart: 0, OldLines: 0, NewStart: 1, NewLines: 1,
+				Lines: []Line{{Kind: LineAdded, Content: "caf", NewNumber: 1}},
+			}},
+		},
+	}
+
+	if len(files) != len(expected) {
+		t.Fatalf("Got: %d files, expected: %d.", len(files), len(expected))
+	}
+
+	for i, file := range files {
+		if !reflect.DeepEqual(file, expected[i]) {
+			t.Errorf("Got: %+v, expected: %+v.", file, expected[i])
+		}
+	}
+}
+
+func TestParse_truncated(t *testing.T) {
+	_, err := Parse(strings.NewReader("diff --git a/a b/a\n--- a/a\n+++ b/a\n@@ -1,2 +1,2 @@\n a\n"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestParseGitPaths(t *testing.T) {
+	testCases := []struct {
+		paths   string
+		oldPath string
+		newPath string
+	}{
+		{paths: "a/foo b/foo", oldPath: "foo", newPath: "foo"},
+		{paths: "a/sp ace b/sp ace", oldPath: "sp ace", newPath: "sp ace"},
+		{paths: "a/foo b/bar", oldPath: "foo", newPath: "bar"},
+		{paths: `"a/t\303\251st" "b/t\303\251st"`, oldPath: "tést", newPath: "tést"},
+		{paths: `a/foo "b/t\303\251st"`, oldPath: "foo", newPath: "tést"},
+	}
+
+	for _, test := range testCases {
+		oldPath, newPath := parseGitPaths(test.paths)
+		if oldPath != test.oldPath || newPath != test.newPath {
+			t.Errorf("%q: Got: %q %q, expected: %q %q.", test.paths, oldPath, newPath, test.oldPath, test.newPath)
+		}
+	}
+}
+
+func TestFiles(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "one\ntwo\n")
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "init")
+
+	repo.WriteFile("a.txt", "one\n2")
+	repo.WriteFile("b c.txt", "new\n")
+	repo.Git("add", ".")
+
+	files, err := Files(context.Background(), global.UpperC(repo.Dir), Cached, Unified("0"), PathSpecs("."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 2 {
+		t.Fatalf("Got: %d files, expected: 2.", len(files))
+	}
+
+	expected := Hunk{
+		OldStart: 2, OldLines: 1, NewStart: 2, NewLines: 1, Section: "one",
+		Lines: []Line{
+			{Kind: LineDeleted, Content: "two", OldNumber: 2},
+			{Kind: LineAdded, Content: "2", NewNumber: 2, NoNewline: true},
+		},
+	}
+
+	if files[0].NewPath != "a.txt" || len(files[0].Hunks) != 1 || !reflect.DeepEqual(files[0].Hunks[0], expected) {
+		t.Fatalf("unexpected file: %+v", files[0])
+	}
+
+	if files[1].Status != StatusAdded || files[1].NewPath != "b c.txt" {
+		t.Fatalf("unexpected file: %+v", files[1])
+	}
+}
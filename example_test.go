@@ -6,25 +6,57 @@ import (
 	"strings"
 
 	"github.com/kumose-go/xgit/add"
+	"github.com/kumose-go/xgit/am"
+	"github.com/kumose-go/xgit/apply"
+	"github.com/kumose-go/xgit/archive"
+	"github.com/kumose-go/xgit/bisect"
+	"github.com/kumose-go/xgit/blame"
 	"github.com/kumose-go/xgit/branch"
+	"github.com/kumose-go/xgit/bundle"
+	"github.com/kumose-go/xgit/catfile"
 	"github.com/kumose-go/xgit/checkout"
+	"github.com/kumose-go/xgit/cherrypick"
+	"github.com/kumose-go/xgit/clean"
 	"github.com/kumose-go/xgit/clone"
 	"github.com/kumose-go/xgit/commit"
 	"github.com/kumose-go/xgit/config"
+	"github.com/kumose-go/xgit/countobjects"
+	"github.com/kumose-go/xgit/describe"
+	"github.com/kumose-go/xgit/diff"
 	"github.com/kumose-go/xgit/fetch"
+	"github.com/kumose-go/xgit/foreachref"
 	"github.com/kumose-go/xgit"
+	"github.com/kumose-go/xgit/formatpatch"
+	"github.com/kumose-go/xgit/fsck"
+	"github.com/kumose-go/xgit/gc"
+	"github.com/kumose-go/xgit/grep"
 	ginit "github.com/kumose-go/xgit/init"
+	"github.com/kumose-go/xgit/log"
 	"github.com/kumose-go/xgit/lsfiles"
+	"github.com/kumose-go/xgit/maintenance"
 	"github.com/kumose-go/xgit/merge"
+	"github.com/kumose-go/xgit/mergebase"
+	"github.com/kumose-go/xgit/mv"
 	"github.com/kumose-go/xgit/notes"
+	"github.com/kumose-go/xgit/prune"
 	"github.com/kumose-go/xgit/pull"
 	"github.com/kumose-go/xgit/push"
 	"github.com/kumose-go/xgit/rebase"
+	"github.com/kumose-go/xgit/reflog"
 	"github.com/kumose-go/xgit/remote"
+	"github.com/kumose-go/xgit/repack"
 	"github.com/kumose-go/xgit/reset"
+	"github.com/kumose-go/xgit/restore"
+	"github.com/kumose-go/xgit/revert"
+	"github.com/kumose-go/xgit/revlist"
 	"github.com/kumose-go/xgit/revparse"
+	"github.com/kumose-go/xgit/rm"
+	"github.com/kumose-go/xgit/shortlog"
+	"github.com/kumose-go/xgit/sparsecheckout"
 	"github.com/kumose-go/xgit/stash"
 	"github.com/kumose-go/xgit/status"
+	gswitch "github.com/kumose-go/xgit/switch"
+	"github.com/kumose-go/xgit/submodule"
 	"github.com/kumose-go/xgit/tag"
 	"github.com/kumose-go/xgit/types"
 	"github.com/kumose-go/xgit/worktree"
@@ -455,10 +487,10 @@ func ExampleNotesWithContext_getRef() {
 }
 
 func ExampleStash_push() {
-	out, _ := xgit.Stash(stash.Push("foo", stash.All), xgit.CmdExecutor(cmdExecutorMock))
+	out, _ := xgit.Stash(stash.Push("foo", []string{"bar.go"}, stash.All), xgit.CmdExecutor(cmdExecutorMock))
 
 	fmt.Println(out)
-	// Output: git stash push --all foo
+	// Output: git stash push --all --message=foo -- bar.go
 }
 
 func ExampleStash_save() {
@@ -532,10 +564,10 @@ func ExampleStash_store() {
 }
 
 func ExampleStashWithContext_push() {
-	out, _ := xgit.StashWithContext(context.Background(), stash.Push("foo", stash.All), xgit.CmdExecutor(cmdExecutorMock))
+	out, _ := xgit.StashWithContext(context.Background(), stash.Push("foo", []string{"bar.go"}, stash.All), xgit.CmdExecutor(cmdExecutorMock))
 
 	fmt.Println(out)
-	// Output: git stash push --all foo
+	// Output: git stash push --all --message=foo -- bar.go
 }
 
 func ExampleStashWithContext_save() {
@@ -608,6 +640,466 @@ func ExampleStashWithContext_store() {
 	// Output: git stash store
 }
 
+func ExampleLog() {
+	out, _ := xgit.Log(log.NoMerges, log.MaxCount("10"), log.Since("2.weeks"), log.Revisions("origin/main..HEAD"), log.PathSpecs("docs/"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git log --no-merges --max-count=10 --since=2.weeks origin/main..HEAD -- docs/
+}
+
+func ExampleLogWithContext() {
+	out, _ := xgit.LogWithContext(context.Background(), log.Author("John"), log.Grep("fix"), log.PickaxeString("TODO"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git log --author=John --grep=fix -S TODO
+}
+
+func ExampleDiff() {
+	out, _ := xgit.Diff(diff.Cached, diff.NameStatus, diff.FindRenames("50%"), diff.Revisions("HEAD~1"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git diff --cached --name-status --find-renames=50% HEAD~1
+}
+
+func ExampleDiffWithContext() {
+	out, _ := xgit.DiffWithContext(context.Background(), diff.IgnoreAllSpace, diff.Unified("0"), diff.DiffAlgorithm("histogram"), diff.Revisions("main...topic"), diff.PathSpecs("src/"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git diff --ignore-all-space --unified=0 --diff-algorithm=histogram main...topic -- src/
+}
+
+func ExampleBlame() {
+	out, _ := xgit.Blame(blame.LineRange("10,20"), blame.IgnoreWhitespace, blame.DetectCopies(""), blame.Revision("v1.0"), blame.File("main.go"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git blame -L 10,20 -w -C v1.0 -- main.go
+}
+
+func ExampleBlameWithContext() {
+	out, _ := xgit.BlameWithContext(context.Background(), blame.LinePorcelain, blame.IgnoreRevsFile(".git-blame-ignore-revs"), blame.Since("3.weeks"), blame.File("main.go"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git blame --line-porcelain --ignore-revs-file .git-blame-ignore-revs --since=3.weeks -- main.go
+}
+
+func ExampleForEachRef() {
+	out, _ := xgit.ForEachRef(foreachref.Sort("-committerdate"), foreachref.Count("5"), foreachref.Format("%(refname:short)"), foreachref.Patterns("refs/heads/"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git for-each-ref --sort=-committerdate --count=5 --format=%(refname:short) refs/heads/
+}
+
+func ExampleForEachRefWithContext() {
+	format := foreachref.FormatString([]foreachref.Atom{foreachref.AtomRefName, foreachref.AtomUpstreamTrack})
+
+	out, _ := xgit.ForEachRefWithContext(context.Background(), foreachref.Format(format), foreachref.Merged("main"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git for-each-ref --format=%(refname)%00%(upstream:track)%00 --merged=main
+}
+
+func ExampleCatFile() {
+	out, _ := xgit.CatFile(catfile.ShowType, catfile.Object("HEAD"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git cat-file -t HEAD
+}
+
+func ExampleCatFileWithContext() {
+	out, _ := xgit.CatFileWithContext(context.Background(), catfile.Type("blob"), catfile.Object("HEAD:README.md"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git cat-file blob HEAD:README.md
+}
+
+func ExampleNewRepo() {
+	repo := xgit.NewRepo("/path/to/repo", xgit.CmdExecutor(cmdExecutorMock))
+	defer func() { _ = repo.Close() }()
+
+	out, _ := repo.Command(context.Background(), "status", status.Short)
+
+	fmt.Println(out)
+	// Output: git -C /path/to/repo status --short
+}
+
+func ExampleRevList() {
+	out, _ := xgit.RevList(revlist.Count, revlist.FirstParent, revlist.Revisions("main..feature"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git rev-list --count --first-parent main..feature
+}
+
+func ExampleRevListWithContext() {
+	out, _ := xgit.RevListWithContext(context.Background(), revlist.Objects, revlist.Filter("blob:none"), revlist.Revisions("HEAD"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git rev-list --objects --filter=blob:none HEAD
+}
+
+func ExampleDescribe() {
+	out, _ := xgit.Describe(describe.Tags, describe.Long, describe.Dirty(""), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git describe --tags --long --dirty
+}
+
+func ExampleDescribeWithContext() {
+	out, _ := xgit.DescribeWithContext(context.Background(), describe.Match("v*"), describe.Abbrev("10"), describe.CommitIsh("HEAD~1"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git describe --match v* --abbrev=10 HEAD~1
+}
+
+func ExampleMergeBase() {
+	out, _ := xgit.MergeBase(mergebase.All, mergebase.Commits("main", "feature"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git merge-base --all main feature
+}
+
+func ExampleMergeBaseWithContext() {
+	out, _ := xgit.MergeBaseWithContext(context.Background(), mergebase.ForkPoint, mergebase.Commits("origin/main", "topic"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git merge-base --fork-point origin/main topic
+}
+
+func ExampleCherryPick() {
+	out, _ := xgit.CherryPick(cherrypick.RecordOrigin, cherrypick.Mainline("1"), cherrypick.Commits("v1.2.3"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git cherry-pick -x --mainline 1 v1.2.3
+}
+
+func ExampleCherryPickWithContext() {
+	out, _ := xgit.CherryPickWithContext(context.Background(), cherrypick.Continue, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git cherry-pick --continue
+}
+
+func ExampleRevert() {
+	out, _ := xgit.Revert(revert.NoEdit, revert.Commits("HEAD~3"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git revert --no-edit HEAD~3
+}
+
+func ExampleRevertWithContext() {
+	out, _ := xgit.RevertWithContext(context.Background(), revert.Abort, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git revert --abort
+}
+
+func ExampleSwitch() {
+	out, _ := xgit.Switch(gswitch.Create("feature", "origin/main"), gswitch.NoTrack, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git switch --create feature origin/main --no-track
+}
+
+func ExampleSwitchWithContext() {
+	out, _ := xgit.SwitchWithContext(context.Background(), gswitch.DiscardChanges, gswitch.Branch("main"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git switch --discard-changes main
+}
+
+func ExampleRestore() {
+	out, _ := xgit.Restore(restore.Source("HEAD~1"), restore.Staged, restore.Worktree, restore.PathSpecs("README.md"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git restore --source=HEAD~1 --staged --worktree -- README.md
+}
+
+func ExampleRestoreWithContext() {
+	out, _ := xgit.RestoreWithContext(context.Background(), restore.Ours, restore.PathSpecs("a.txt", "b.txt"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git restore --ours -- a.txt b.txt
+}
+
+func ExampleRm() {
+	out, _ := xgit.Rm(rm.Cached, rm.Recursive, rm.PathSpecs("build"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git rm --cached -r -- build
+}
+
+func ExampleRmWithContext() {
+	out, _ := xgit.RmWithContext(context.Background(), rm.DryRun, rm.IgnoreUnmatch, rm.PathSpecs("*.log"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git rm --dry-run --ignore-unmatch -- *.log
+}
+
+func ExampleMv() {
+	out, _ := xgit.Mv(mv.Sources("old.go"), mv.Destination("new.go"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git mv old.go new.go
+}
+
+func ExampleMvWithContext() {
+	out, _ := xgit.MvWithContext(context.Background(), mv.SkipErrors, mv.DryRun, mv.Sources("a.go", "b.go"), mv.Destination("pkg"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git mv -k --dry-run a.go b.go pkg
+}
+
+func ExampleClean() {
+	out, _ := xgit.Clean(clean.Force, clean.Directories, clean.NoIgnoreRules, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git clean --force -d -x
+}
+
+func ExampleCleanWithContext() {
+	out, _ := xgit.CleanWithContext(context.Background(), clean.DryRun, clean.Exclude(".env"), clean.PathSpecs("tmp"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git clean --dry-run --exclude=.env -- tmp
+}
+
+func ExampleSubmodule() {
+	out, _ := xgit.Submodule(submodule.Update(nil, submodule.UpdateInit, submodule.Recursive, submodule.Jobs("4")), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git submodule update --init --recursive --jobs 4
+}
+
+func ExampleSubmoduleWithContext() {
+	out, _ := xgit.SubmoduleWithContext(context.Background(), submodule.Status([]string{"deps/lib"}, submodule.Cached), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git submodule status --cached -- deps/lib
+}
+
+func ExampleSparseCheckout() {
+	out, _ := xgit.SparseCheckout(sparsecheckout.Set([]sparsecheckout.Pattern{"src/app", "docs"}, sparsecheckout.Cone, sparsecheckout.SparseIndex), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git sparse-checkout set --cone --sparse-index src/app docs
+}
+
+func ExampleSparseCheckoutWithContext() {
+	out, _ := xgit.SparseCheckoutWithContext(context.Background(), sparsecheckout.List(), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git sparse-checkout list
+}
+
+func ExampleBisect() {
+	out, _ := xgit.Bisect(bisect.Start("main", []string{"v1.0"}, []string{"src"}, bisect.FirstParent), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git bisect start --first-parent main v1.0 -- src
+}
+
+func ExampleBisectWithContext() {
+	out, _ := xgit.BisectWithContext(context.Background(), bisect.Good("HEAD~3", "HEAD~5"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git bisect good HEAD~3 HEAD~5
+}
+
+func ExampleReflog() {
+	out, _ := xgit.Reflog(reflog.Expire(nil, reflog.ExpireTime("30.days.ago"), reflog.All), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git reflog expire --expire=30.days.ago --all
+}
+
+func ExampleReflogWithContext() {
+	out, _ := xgit.ReflogWithContext(context.Background(), reflog.Delete([]string{"HEAD@{2}"}, reflog.Rewrite), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git reflog delete --rewrite HEAD@{2}
+}
+
+func ExampleGC() {
+	out, _ := xgit.GC(gc.Auto, gc.Prune("now"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git gc --auto --prune=now
+}
+
+func ExampleGCWithContext() {
+	out, _ := xgit.GCWithContext(context.Background(), gc.Aggressive, gc.Quiet, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git gc --aggressive --quiet
+}
+
+func ExampleMaintenance() {
+	out, _ := xgit.Maintenance(maintenance.Run(maintenance.Task(maintenance.TaskGC), maintenance.Task(maintenance.TaskCommitGraph)), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git maintenance run --task=gc --task=commit-graph
+}
+
+func ExampleMaintenanceWithContext() {
+	out, _ := xgit.MaintenanceWithContext(context.Background(), maintenance.Start(maintenance.Scheduler("crontab")), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git maintenance start --scheduler=crontab
+}
+
+func ExampleRepack() {
+	out, _ := xgit.Repack(repack.All, repack.Delete, repack.WriteBitmapIndex, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git repack -a -d --write-bitmap-index
+}
+
+func ExampleRepackWithContext() {
+	out, _ := xgit.RepackWithContext(context.Background(), repack.Geometric("2"), repack.WriteMidx, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git repack --geometric=2 --write-midx
+}
+
+func ExamplePrune() {
+	out, _ := xgit.Prune(prune.DryRun, prune.Expire("2.weeks.ago"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git prune --dry-run --expire 2.weeks.ago
+}
+
+func ExamplePruneWithContext() {
+	out, _ := xgit.PruneWithContext(context.Background(), prune.Verbose, prune.Heads("refs/keep/a"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git prune --verbose -- refs/keep/a
+}
+
+func ExampleFsck() {
+	out, _ := xgit.Fsck(fsck.ConnectivityOnly, fsck.NoDangling, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git fsck --connectivity-only --no-dangling
+}
+
+func ExampleFsckWithContext() {
+	out, _ := xgit.FsckWithContext(context.Background(), fsck.Unreachable, fsck.Objects("HEAD"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git fsck --unreachable HEAD
+}
+
+func ExampleCountObjects() {
+	out, _ := xgit.CountObjects(countobjects.Verbose, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git count-objects --verbose
+}
+
+func ExampleCountObjectsWithContext() {
+	out, _ := xgit.CountObjectsWithContext(context.Background(), countobjects.Verbose, countobjects.HumanReadable, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git count-objects --verbose --human-readable
+}
+
+func ExampleArchive() {
+	out, _ := xgit.Archive(archive.Format(archive.FormatTarGz), archive.Prefix("project-1.0/"), archive.TreeIsh("v1.0"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git archive --format=tar.gz --prefix=project-1.0/ v1.0
+}
+
+func ExampleArchiveWithContext() {
+	out, _ := xgit.ArchiveWithContext(context.Background(), archive.Format(archive.FormatZip), archive.Output("src.zip"), archive.TreeIsh("HEAD"), archive.PathSpecs("src"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git archive --format=zip --output=src.zip HEAD -- src
+}
+
+func ExampleBundle() {
+	out, _ := xgit.Bundle(bundle.Create("repo.bundle", []types.Option{revlist.All}, bundle.Quiet), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git bundle create --quiet repo.bundle --all
+}
+
+func ExampleBundleWithContext() {
+	out, _ := xgit.BundleWithContext(context.Background(), bundle.Create("recent.bundle", []types.Option{revlist.Since("10.days.ago"), revlist.Revisions("main")}), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git bundle create recent.bundle --since=10.days.ago main
+}
+
+func ExampleFormatPatch() {
+	out, _ := xgit.FormatPatch(formatpatch.CoverLetter, formatpatch.Numbered, formatpatch.OutputDirectory("outgoing"), formatpatch.Revisions("main..feature"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git format-patch --cover-letter --numbered --output-directory outgoing main..feature
+}
+
+func ExampleFormatPatchWithContext() {
+	out, _ := xgit.FormatPatchWithContext(context.Background(), formatpatch.Stdout, formatpatch.Base("auto"), formatpatch.Revisions("-3"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git format-patch --stdout --base=auto -3
+}
+
+func ExampleAm() {
+	out, _ := xgit.Am(am.ThreeWay, am.Signoff, am.Mbox("series.mbox"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git am --3way --signoff series.mbox
+}
+
+func ExampleAmWithContext() {
+	out, _ := xgit.AmWithContext(context.Background(), am.Abort, xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git am --abort
+}
+
+func ExampleApply() {
+	out, _ := xgit.Apply(apply.Check, apply.Index, apply.Patches("fix.patch"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git apply --check --index fix.patch
+}
+
+func ExampleApplyWithContext() {
+	out, _ := xgit.ApplyWithContext(context.Background(), apply.Reject, apply.Verbose, apply.Patches("fix.patch"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git apply --reject --verbose fix.patch
+}
+
+func ExampleGrep() {
+	out, _ := xgit.Grep(grep.LineNumber, grep.IgnoreCase, grep.Pattern("TODO"), grep.Trees("HEAD"), grep.PathSpecs("*.go"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git grep --line-number --ignore-case -e TODO HEAD -- *.go
+}
+
+func ExampleGrepWithContext() {
+	out, _ := xgit.GrepWithContext(context.Background(), grep.Cached, grep.Pattern("foo"), grep.And, grep.Not, grep.Pattern("bar"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git grep --cached -e foo --and --not -e bar
+}
+
+func ExampleShortlog() {
+	out, _ := xgit.Shortlog(shortlog.Summary, shortlog.Numbered, shortlog.Email, shortlog.Revisions("v1.0..v2.0"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git shortlog --summary --numbered --email v1.0..v2.0
+}
+
+func ExampleShortlogWithContext() {
+	out, _ := xgit.ShortlogWithContext(context.Background(), shortlog.Summary, shortlog.Group(shortlog.GroupTrailer("co-authored-by")), shortlog.Revisions("HEAD"), xgit.CmdExecutor(cmdExecutorMock))
+
+	fmt.Println(out)
+	// Output: git shortlog --summary --group=trailer:co-authored-by HEAD
+}
+
 func ExampleRaw() {
 	out, _ := xgit.Raw("stash", xgit.CmdExecutor(cmdExecutorMock), func(g *types.Cmd) {
 		g.AddOptions("list")
@@ -4,7 +4,7 @@ import (
 	"github.com/kumose-go/xgit/types"
 )
 
-// Remote name.
+// Remote name, URL or bundle file (see the bundle package).
 func Remote(name string) types.Option {
 	return func(g *types.Cmd) {
 		g.AddOptions(name)
@@ -0,0 +1,19 @@
+/*
+Package foreachref git-for-each-ref - Output information on each ref.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-for-each-ref
+
+	git for-each-ref [--count=<count>] [--shell|--perl|--python|--tcl]
+			   [(--sort=<key>)…​] [--format=<format>]
+			   [--points-at=<object>]
+			   [--merged[=<object>]] [--no-merged[=<object>]]
+			   [--contains[=<object>]] [--no-contains[=<object>]]
+			   [--exclude=<pattern> …​]
+
+# DESCRIPTION
+
+Iterate over all refs that match <pattern> and show them according to the given <format>, after sorting them according to the given set of <key>. If <count> is given, stop after showing that many refs. The interpolated values in <format> can optionally be quoted as string literals in the specified host language allowing their direct evaluation in that language.
+*/
+package foreachref
@@ -0,0 +1,396 @@
+package foreachref
+
+import (
+	"bufio"
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+const tagName = "foreachref"
+
+var (
+	timeType            = reflect.TypeOf(time.Time{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	expTrack            = regexp.MustCompile(`(ahead|behind) (\d+)`)
+)
+
+// Atom A field name of the format (ex: `refname`, `upstream:track`, `*objectname`).
+type Atom string
+
+// Usual atoms.
+const (
+	AtomRefName            Atom = "refname"
+	AtomRefNameShort       Atom = "refname:short"
+	AtomObjectType         Atom = "objecttype"
+	AtomObjectSize         Atom = "objectsize"
+	AtomObjectName         Atom = "objectname"
+	AtomObjectNameShort    Atom = "objectname:short"
+	AtomTree               Atom = "tree"
+	AtomParent             Atom = "parent"
+	AtomUpstream           Atom = "upstream"
+	AtomUpstreamShort      Atom = "upstream:short"
+	AtomUpstreamTrack      Atom = "upstream:track"
+	AtomUpstreamRemoteName Atom = "upstream:remotename"
+	AtomPush               Atom = "push"
+	AtomPushTrack          Atom = "push:track"
+	AtomHEAD               Atom = "HEAD"
+	AtomSymRef             Atom = "symref"
+	AtomWorktreePath       Atom = "worktreepath"
+	AtomCreator            Atom = "creator"
+	AtomCreatorDate        Atom = "creatordate"
+	AtomAuthorName         Atom = "authorname"
+	AtomAuthorEmail        Atom = "authoremail"
+	AtomAuthorDate         Atom = "authordate"
+	AtomCommitterName      Atom = "committername"
+	AtomCommitterEmail     Atom = "committeremail"
+	AtomCommitterDate      Atom = "committerdate"
+	AtomTag                Atom = "tag"
+	AtomTaggerName         Atom = "taggername"
+	AtomTaggerEmail        Atom = "taggeremail"
+	AtomTaggerDate         Atom = "taggerdate"
+	AtomSubject            Atom = "subject"
+	AtomBody               Atom = "body"
+	AtomContents           Atom = "contents"
+	// AtomDerefObjectName The object name of the object pointed by an annotated tag.
+	AtomDerefObjectName Atom = "*objectname"
+	// AtomDerefObjectType The type of the object pointed by an annotated tag.
+	AtomDerefObjectType Atom = "*objecttype"
+)
+
+// Deref Returns the atom applied to the object pointed by an annotated tag (ex: `*objectname`).
+func Deref(atom Atom) Atom {
+	return "*" + atom
+}
+
+// With Returns the atom with a modifier (ex: `AtomRefName.With("lstrip=2")` for `refname:lstrip=2`).
+// Only the `track` modifier (`upstream:track`, `push:track`) and the trailers options accept several comma-separated modifiers:
+// the modifier is added (ex: `AtomUpstreamTrack.With("nobracket")` for `upstream:track,nobracket`, `trailers:only,unfold`).
+// The other atoms accept a single modifier: it replaces the current one (ex: `AtomRefNameShort.With("lstrip=2")` for `refname:lstrip=2`).
+func (a Atom) With(modifier string) Atom {
+	name, current, found := strings.Cut(string(a), ":")
+
+	switch {
+	case !found, current == "trailers":
+		return a + ":" + Atom(modifier)
+	case current == "track" || strings.HasPrefix(current, "track,"),
+		name == "trailers" || name == "*trailers" || strings.HasPrefix(current, "trailers:"):
+		return a + "," + Atom(modifier)
+	default:
+		return Atom(name) + ":" + Atom(modifier)
+	}
+}
+
+// Record The values of the atoms for a ref.
+type Record map[Atom]string
+
+// Track The ahead/behind counts of `upstream:track` or `push:track` (ex: `[ahead 1, behind 2]`, `[gone]`).
+// Can be used as the type of a field decoded by Decode.
+type Track struct {
+	Ahead  int
+	Behind int
+	// Gone True when the upstream is configured but does not exist.
+	Gone bool
+}
+
+// ParseTrack Parses the value of `upstream:track` or `push:track` (with or without brackets).
+func ParseTrack(value string) (Track, error) {
+	var t Track
+
+	value = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]")
+
+	switch value {
+	case "":
+		return t, nil
+	case "gone":
+		t.Gone = true
+		return t, nil
+	}
+
+	matches := expTrack.FindAllStringSubmatch(value, -1)
+	if len(matches) == 0 {
+		return t, fmt.Errorf("invalid track: %q", value)
+	}
+
+	for _, match := range matches {
+		n, err := strconv.Atoi(match[2])
+		if err != nil {
+			return t, err
+		}
+
+		if match[1] == "ahead" {
+			t.Ahead = n
+		} else {
+			t.Behind = n
+		}
+	}
+
+	return t, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Track) UnmarshalText(text []byte) error {
+	track, err := ParseTrack(string(text))
+	if err != nil {
+		return err
+	}
+
+	*t = track
+
+	return nil
+}
+
+// FormatString Returns the `--format` value of the atoms: the values are NUL separated.
+func FormatString(atoms []Atom) string {
+	var b strings.Builder
+
+	for _, atom := range atoms {
+		b.WriteString("%(")
+		b.WriteString(string(atom))
+		b.WriteString(")%00")
+	}
+
+	return b.String()
+}
+
+// Records Streams the values of the atoms for each ref, using `git for-each-ref`.
+// The Format option must not be used.
+func Records(ctx context.Context, atoms []Atom, options ...types.Option) iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		if len(atoms) == 0 {
+			yield(nil, errors.New("at least one atom is required"))
+			return
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("for-each-ref")
+		g.ApplyOptions(Format(FormatString(atoms)))
+		g.ApplyOptions(options...)
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		reader := bufio.NewReader(stdout)
+
+		for {
+			values, err := readRecord(reader, len(atoms))
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			record := make(Record, len(atoms))
+			for i, atom := range atoms {
+				record[atom] = values[i]
+			}
+
+			if !yield(record, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Decode Lists the refs into the slice pointed by v.
+// The elements of the slice are structs (or pointers to structs) whose fields are mapped with the `foreachref:"<atom>"` tag:
+//   - string, booleans (`HEAD` is true for `*`), integers, encoding.TextUnmarshaler (ex: Track).
+//   - time.Time: the dates are requested in the strict ISO 8601 format when the atom has no modifier,
+//     the `:unix` modifier is also supported.
+func Decode(ctx context.Context, v any, options ...types.Option) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("decode: a non-nil pointer to a slice is required, got %T", v)
+	}
+
+	slice := rv.Elem()
+	elemType := slice.Type().Elem()
+
+	structType := elemType
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	if structType.Kind() != reflect.Struct {
+		return fmt.Errorf("decode: a slice of structs is required, got %T", v)
+	}
+
+	atoms, indexes := structAtoms(structType)
+	if len(atoms) == 0 {
+		return fmt.Errorf("decode: no field with a %q tag in %s", tagName, structType)
+	}
+
+	for record, err := range Records(ctx, atoms, options...) {
+		if err != nil {
+			return err
+		}
+
+		elem := reflect.New(structType).Elem()
+
+		for i, atom := range atoms {
+			err = setField(elem.Field(indexes[i]), record[atom])
+			if err != nil {
+				return fmt.Errorf("%s: %w", atom, err)
+			}
+		}
+
+		if elemType.Kind() == reflect.Pointer {
+			elem = elem.Addr()
+		}
+
+		slice.Set(reflect.Append(slice, elem))
+	}
+
+	return nil
+}
+
+// structAtoms returns the atoms of the tagged fields, and the indexes of these fields.
+func structAtoms(structType reflect.Type) ([]Atom, []int) {
+	var (
+		atoms   []Atom
+		indexes []int
+	)
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		tag, ok := field.Tag.Lookup(tagName)
+		if !ok || tag == "" || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		atom := Atom(tag)
+		if field.Type == timeType && !strings.Contains(tag, ":") {
+			atom = atom.With("iso-strict")
+		}
+
+		atoms = append(atoms, atom)
+		indexes = append(indexes, i)
+	}
+
+	return atoms, indexes
+}
+
+func setField(fv reflect.Value, value string) error {
+	if fv.Type() == timeType {
+		t, err := parseTime(value)
+		if err != nil {
+			return err
+		}
+
+		fv.Set(reflect.ValueOf(t))
+
+		return nil
+	}
+
+	if fv.Addr().Type().Implements(textUnmarshalerType) {
+		//nolint:forcetypeassert // checked by Implements.
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(value)
+
+	case reflect.Bool:
+		switch strings.TrimSpace(value) {
+		case "", "false":
+			fv.SetBool(false)
+		case "*", "true":
+			fv.SetBool(true)
+		default:
+			return fmt.Errorf("invalid boolean: %q", value)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if value == "" {
+			return nil
+		}
+
+		i, err := strconv.ParseInt(value, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		fv.SetInt(i)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if value == "" {
+			return nil
+		}
+
+		i, err := strconv.ParseUint(value, 10, fv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		fv.SetUint(i)
+
+	default:
+		return fmt.Errorf("unsupported type: %s", fv.Type())
+	}
+
+	return nil
+}
+
+// parseTime parses a strict ISO 8601 date or a Unix timestamp.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return t, nil
+	}
+
+	sec, errUnix := strconv.ParseInt(value, 10, 64)
+	if errUnix != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %q", value)
+	}
+
+	return time.Unix(sec, 0), nil
+}
+
+// readRecord reads the NUL terminated values of a ref, followed by a line feed.
+func readRecord(r *bufio.Reader, size int) ([]string, error) {
+	values := make([]string, size)
+
+	for i := range values {
+		value, err := r.ReadString(0)
+		if i == 0 {
+			value = strings.TrimPrefix(value, "\n")
+		}
+
+		if errors.Is(err, io.EOF) {
+			if i == 0 && value == "" {
+				return nil, io.EOF
+			}
+
+			return nil, fmt.Errorf("truncated record: %q", append(values[:i], value))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		values[i] = strings.TrimSuffix(value, "\x00")
+	}
+
+	return values, nil
+}
@@ -0,0 +1,151 @@
+package foreachref
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestFormatString(t *testing.T) {
+	format := FormatString([]Atom{AtomRefName, AtomUpstreamTrack.With("nobracket"), Deref(AtomObjectName)})
+
+	expected := "%(refname)%00%(upstream:track,nobracket)%00%(*objectname)%00"
+	if format != expected {
+		t.Fatalf("Got: %s, expected: %s.", format, expected)
+	}
+}
+
+func TestAtom_With(t *testing.T) {
+	testCases := []struct {
+		atom     Atom
+		modifier string
+		expected Atom
+	}{
+		{atom: AtomRefName, modifier: "lstrip=2", expected: "refname:lstrip=2"},
+		{atom: AtomRefNameShort, modifier: "lstrip=2", expected: "refname:lstrip=2"},
+		{atom: AtomUpstreamTrack, modifier: "nobracket", expected: "upstream:track,nobracket"},
+		{atom: "trailers:only", modifier: "unfold", expected: "trailers:only,unfold"},
+		{atom: "contents:trailers", modifier: "only", expected: "contents:trailers:only"},
+		{atom: "contents:trailers:only", modifier: "unfold", expected: "contents:trailers:only,unfold"},
+	}
+
+	for _, test := range testCases {
+		if atom := test.atom.With(test.modifier); atom != test.expected {
+			t.Errorf("%s.With(%q): Got: %s, expected: %s.", test.atom, test.modifier, atom, test.expected)
+		}
+	}
+}
+
+func TestParseTrack(t *testing.T) {
+	testCases := map[string]Track{
+		"":                     {},
+		"[gone]":               {Gone: true},
+		"[ahead 2]":            {Ahead: 2},
+		"[behind 3]":           {Behind: 3},
+		"[ahead 1, behind 12]": {Ahead: 1, Behind: 12},
+		"ahead 1, behind 12":   {Ahead: 1, Behind: 12},
+	}
+
+	for value, expected := range testCases {
+		track, err := ParseTrack(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if track != expected {
+			t.Errorf("%q: Got: %+v, expected: %+v.", value, track, expected)
+		}
+	}
+
+	_, err := ParseTrack("[diverged]")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+type branchInfo struct {
+	Name    string    `foreachref:"refname:short"`
+	Hash    string    `foreachref:"objectname"`
+	Head    bool      `foreachref:"HEAD"`
+	Track   Track     `foreachref:"upstream:track"`
+	Date    time.Time `foreachref:"committerdate"`
+	Subject string    `foreachref:"subject"`
+	Ignored string
+}
+
+type tagInfo struct {
+	Name   string `foreachref:"refname:lstrip=2"`
+	Type   string `foreachref:"objecttype"`
+	Target string `foreachref:"*objectname"`
+	Size   int    `foreachref:"objectsize"`
+}
+
+func TestDecode(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.Git("commit", "-q", "--allow-empty", "-m", "init")
+	repo.Git("tag", "-a", "-m", "release", "v1.0")
+	repo.Git("checkout", "-q", "-b", "feature", "--track", "main")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "multi\nline")
+	head := repo.Git("rev-parse", "HEAD")
+	initial := repo.Git("rev-parse", "main")
+
+	var branches []branchInfo
+
+	err := Decode(context.Background(), &branches, global.UpperC(repo.Dir), Sort("-refname"), Patterns("refs/heads"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(branches) != 2 {
+		t.Fatalf("Got: %d branches, expected: 2.", len(branches))
+	}
+
+	for i := range branches {
+		if branches[i].Date.IsZero() {
+			t.Fatalf("the date must be decoded: %+v", branches[i])
+		}
+
+		branches[i].Date = time.Time{}
+	}
+
+	expected := []branchInfo{
+		{Name: "main", Hash: initial, Subject: "init"},
+		// the lines of the first paragraph are joined.
+		{Name: "feature", Hash: head, Head: true, Track: Track{Ahead: 1}, Subject: "multi line"},
+	}
+
+	if !reflect.DeepEqual(branches, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", branches, expected)
+	}
+
+	var tags []*tagInfo
+
+	err = Decode(context.Background(), &tags, global.UpperC(repo.Dir), Patterns("refs/tags"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 1 || tags[0].Name != "v1.0" || tags[0].Type != "tag" || tags[0].Target != initial || tags[0].Size == 0 {
+		t.Fatalf("unexpected tags: %+v", tags)
+	}
+
+	var records []Record
+
+	for record, err := range Records(context.Background(), []Atom{AtomRefName, AtomBody}, global.UpperC(repo.Dir), Count("1"), PointsAt(initial)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		records = append(records, record)
+	}
+
+	expectedRecords := []Record{{AtomRefName: "refs/heads/main", AtomBody: ""}}
+	if !reflect.DeepEqual(records, expectedRecords) {
+		t.Fatalf("Got: %+v, expected: %+v.", records, expectedRecords)
+	}
+}
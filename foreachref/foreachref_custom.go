@@ -0,0 +1,14 @@
+package foreachref
+
+import "github.com/kumose-go/xgit/types"
+
+// Patterns [<pattern>...]
+// If one or more patterns are given, only refs are shown that match against at least one pattern,
+// either using fnmatch(3) or literally, in the latter case matching completely or from the beginning up to a slash.
+func Patterns(patterns ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, pattern := range patterns {
+			g.AddOptions(pattern)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Package formatpatch git-format-patch - Prepare patches for e-mail submission.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-format-patch
+
+	git format-patch [-k] [(-o|--output-directory) <dir> | --stdout]
+			   [--no-thread | --thread[=<style>]]
+			   [(--attach|--inline)[=<boundary>] | --no-attach]
+			   [-s | --signoff]
+			   [--signature=<signature> | --no-signature]
+			   [--signature-file=<file>]
+			   [-n | --numbered | -N | --no-numbered]
+			   [--start-number <n>] [--numbered-files]
+			   [--in-reply-to=<message id>] [--suffix=.<sfx>]
+			   [--ignore-if-in-upstream] [--always]
+			   [--cover-from-description=<mode>]
+			   [--rfc] [--subject-prefix=<subject prefix>]
+			   [(--reroll-count|-v) <n>]
+			   [--to=<email>] [--cc=<email>]
+			   [--[no-]cover-letter] [--quiet]
+			   [--[no-]encode-email-headers]
+			   [--no-notes | --notes[=<ref>]]
+			   [--interdiff=<previous>]
+			   [--range-diff=<previous> [--creation-factor=<percent>]]
+			   [--filename-max-length=<n>]
+			   [--progress]
+			   [<common diff options>]
+			   [ <since> | <revision range> ]
+
+# DESCRIPTION
+
+Prepare each non-merge commit with its "patch" in one "message" per commit, formatted to resemble a UNIX mailbox.
+The output of this command is convenient for e-mail submission or for use with git am.
+
+There are two ways to specify which commits to operate on.
+
+1. A single commit, <since>, specifies that the commits leading to the tip of the current branch that are not in the history that leads to the <since> to be output.
+
+2. Generic <revision range> expression (see "SPECIFYING REVISIONS" section in gitrevisions(7)) means the commits in the specified range.
+
+By default, each output file is numbered sequentially from 1, and uses the first line of the commit message (massaged for pathname safety) as the filename.
+With the --numbered-files option, the output file names will only be numbers, without the first line of the commit appended.
+The names of the output files are printed to standard output, unless the --stdout option is specified.
+
+If -o is specified, output files are created in <dir>. Otherwise they are created in the current working directory.
+
+Files returns the names of the generated files, and Stream writes the patches in mbox format to an io.Writer.
+*/
+package formatpatch
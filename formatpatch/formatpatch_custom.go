@@ -0,0 +1,33 @@
+package formatpatch
+
+import (
+	"github.com/kumose-go/xgit/types"
+)
+
+// Revisions A single commit (<since>: the commits of the current branch that are not in its history),
+// or a revision range (ex: `main..feature`, `-3`).
+// [ <since> | <revision range> ]
+func Revisions(revisions ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, revision := range revisions {
+			g.AddOptions(revision)
+		}
+	}
+}
+
+// PathSpecs Limits the patches to the given paths.
+// Must be used after Revisions.
+// [--] [<path>...]
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		if len(pathSpecs) == 0 {
+			return
+		}
+
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
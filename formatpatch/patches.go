@@ -0,0 +1,52 @@
+package formatpatch
+
+import (
+	"context"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Files Writes one file per commit (and the cover letter with CoverLetter), using `git format-patch`,
+// and returns the names of the files, in order.
+// The names are relative to the working directory of the command, and include the OutputDirectory, if any.
+// The Stdout and Quiet options must not be used.
+func Files(ctx context.Context, revisions []string, options ...types.Option) ([]string, error) {
+	g := types.NewCmd("format-patch")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Revisions(revisions...))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+
+	for _, line := range strings.Split(output, "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+
+	return files, nil
+}
+
+// Stream Writes the patches of the commits to w, in mbox format, using `git format-patch --stdout`:
+// the output can be given to `git am` (see am.ApplyInfo).
+// The OutputDirectory option must not be used.
+func Stream(ctx context.Context, w io.Writer, revisions []string, options ...types.Option) error {
+	g := types.NewCmd("format-patch")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Stdout, Revisions(revisions...))
+	g.Stdout = w
+
+	stderr, err := g.Exec(ctx, g.Base, g.Debug, slices.Concat(g.BaseOptions, g.Options)...)
+	if err != nil {
+		return &types.Error{Err: err, Stderr: stderr}
+	}
+
+	return nil
+}
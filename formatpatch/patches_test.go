@@ -0,0 +1,61 @@
+package formatpatch
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestFiles(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.Git("commit", "-q", "--allow-empty", "-m", "init")
+	base := repo.Git("rev-parse", "HEAD")
+
+	for _, name := range []string{"a", "b"} {
+		repo.WriteFile(name+".txt", name+"\n")
+		repo.Git("add", name+".txt")
+		repo.Git("commit", "-q", "-m", "add "+name)
+	}
+
+	ctx := context.Background()
+
+	files, err := Files(ctx, []string{"main~2..main"}, global.UpperC(repo.Dir), global.LowerC("user.name", "test"),
+		global.LowerC("user.email", "test@example.com"), OutputDirectory("out"), CoverLetter, Numbered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"out/0000-cover-letter.patch", "out/0001-add-a.patch", "out/0002-add-b.patch"}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", files, expected)
+	}
+
+	content, err := os.ReadFile(filepath.Join(repo.Dir, files[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), "Subject: [PATCH 1/2] add a") {
+		t.Fatalf("unexpected patch: %s", content)
+	}
+
+	var buf bytes.Buffer
+
+	err = Stream(ctx, &buf, []string{"-1"}, global.UpperC(repo.Dir), Base(base), Rfc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	output := buf.String()
+	if !strings.Contains(output, "Subject: [RFC PATCH] add b") || !strings.Contains(output, "base-commit: "+base) {
+		t.Fatalf("unexpected patch: %s", output)
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Package fsck git-fsck - Verifies the connectivity and validity of the objects in the database.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-fsck
+
+	git fsck [--tags] [--root] [--unreachable] [--cache] [--no-reflogs]
+		 [--[no-]full] [--strict] [--verbose] [--lost-found]
+		 [--[no-]dangling] [--[no-]progress] [--connectivity-only]
+		 [--[no-]name-objects] [<object>...]
+
+# DESCRIPTION
+
+Verifies the connectivity and validity of the objects in the database.
+*/
+package fsck
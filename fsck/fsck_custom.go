@@ -0,0 +1,14 @@
+package fsck
+
+import "github.com/kumose-go/xgit/types"
+
+// Objects An object to treat as the head of an unreachability trace.
+// If no objects are given, git fsck defaults to using the index file, all SHA-1 references in refs namespace, and all reflogs (unless --no-reflogs is given) as heads.
+// [<object>...]
+func Objects(objects ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, object := range objects {
+			g.AddOptions(object)
+		}
+	}
+}
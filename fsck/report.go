@@ -0,0 +1,127 @@
+package fsck
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+var (
+	// `error: <oid>: object corrupt or missing: <path>`.
+	expCorruptObject = regexp.MustCompile(`^error: ([0-9a-f]{40,64}): (.+)$`)
+	// `error in <type> <oid>: <message>`, `warning in <type> <oid>: <message>`.
+	expObjectMessage = regexp.MustCompile(`^(error|warning) in (\w+) ([0-9a-f]{40,64}): (.+)$`)
+	// `error: hash mismatch for <path> (expected <oid>)`.
+	expMismatch = regexp.MustCompile(`^error: (.+\(expected ([0-9a-f]{40,64})\))$`)
+)
+
+// Finding An object reported by git fsck.
+type Finding struct {
+	// Type The type of the object (blob, tree, commit, tag), empty if unknown.
+	Type string
+	OID  string
+	// Message The description of the problem (corrupt objects and warnings).
+	Message string
+}
+
+// Report The findings of git fsck.
+type Report struct {
+	// Dangling The objects not used by any other object.
+	Dangling []Finding
+	// Unreachable The objects not reachable from the refs (only reported with Unreachable).
+	Unreachable []Finding
+	// Missing The objects referenced but not found (or not readable).
+	Missing []Finding
+	// Corrupt The objects that cannot be read or that are invalid.
+	Corrupt []Finding
+	// Warnings The objects with non-fatal problems (ex: a bad date in a commit).
+	Warnings []Finding
+	// Errors The other errors (ex: `inflate: data stream error`).
+	Errors []string
+}
+
+// OK Returns true if no missing or corrupt object was found.
+func (r Report) OK() bool {
+	return len(r.Missing) == 0 && len(r.Corrupt) == 0 && len(r.Errors) == 0
+}
+
+// Check Verifies the objects with git fsck, and returns the findings.
+// A non-zero exit status caused by the findings is not an error.
+// The command runs with `LC_ALL=C`, because the messages are parsed.
+func Check(ctx context.Context, options ...types.Option) (Report, error) {
+	g := types.NewCmd("fsck")
+	g.ApplyOptions(options...)
+	g.Env = append(g.Env, "LC_ALL=C")
+
+	output, err := g.Output(ctx)
+
+	var stderr string
+
+	if err != nil {
+		// the findings use the low bits of the exit status, 128 is a fatal error.
+		code := types.ExitCode(err)
+		if code < 1 || code >= 128 {
+			return Report{}, err
+		}
+
+		var cmdErr *types.Error
+		if errors.As(err, &cmdErr) {
+			stderr = cmdErr.Stderr
+		}
+	}
+
+	return Parse(output, stderr), nil
+}
+
+// Parse Parses the standard output and the standard error of git fsck.
+func Parse(stdout, stderr string) Report {
+	var report Report
+
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+
+		switch {
+		case len(fields) != 3:
+			// roots, tags, broken links (followed by the missing objects)...
+		case fields[0] == "dangling":
+			report.Dangling = append(report.Dangling, Finding{Type: fields[1], OID: fields[2]})
+		case fields[0] == "unreachable":
+			report.Unreachable = append(report.Unreachable, Finding{Type: fields[1], OID: fields[2]})
+		case fields[0] == "missing":
+			report.Missing = append(report.Missing, Finding{Type: fields[1], OID: fields[2]})
+		}
+	}
+
+	for _, line := range strings.Split(stderr, "\n") {
+		if match := expObjectMessage.FindStringSubmatch(line); match != nil {
+			finding := Finding{Type: match[2], OID: match[3], Message: match[4]}
+
+			if match[1] == "error" {
+				report.Corrupt = append(report.Corrupt, finding)
+			} else {
+				report.Warnings = append(report.Warnings, finding)
+			}
+
+			continue
+		}
+
+		if match := expCorruptObject.FindStringSubmatch(line); match != nil {
+			report.Corrupt = append(report.Corrupt, Finding{OID: match[1], Message: match[2]})
+			continue
+		}
+
+		if match := expMismatch.FindStringSubmatch(line); match != nil {
+			report.Corrupt = append(report.Corrupt, Finding{OID: match[2], Message: match[1]})
+			continue
+		}
+
+		if message, found := strings.CutPrefix(line, "error: "); found {
+			report.Errors = append(report.Errors, message)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,100 @@
+package fsck
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestParse(t *testing.T) {
+	stdout := `root 1111111111111111111111111111111111111111
+dangling blob 2222222222222222222222222222222222222222
+unreachable commit 3333333333333333333333333333333333333333
+missing tree 4444444444444444444444444444444444444444
+broken link from  commit 5555555555555555555555555555555555555555
+              to    tree 4444444444444444444444444444444444444444
+`
+	stderr := `error: inflate: data stream error (incorrect header check)
+error: 6666666666666666666666666666666666666666: object corrupt or missing: .git/objects/66/66666666666666666666666666666666666666
+error in commit 7777777777777777777777777777777777777777: missingAuthor: invalid format - expected 'author' line
+warning in tag 8888888888888888888888888888888888888888: missingTaggerEntry: invalid format - expected 'tagger' line
+error: hash mismatch for .git/objects/99/99999999999999999999999999999999999999 (expected 9999999999999999999999999999999999999999)
+`
+
+	report := Parse(stdout, stderr)
+
+	expected := Report{
+		Dangling:    []Finding{{Type: "blob", OID: "2222222222222222222222222222222222222222"}},
+		Unreachable: []Finding{{Type: "commit", OID: "3333333333333333333333333333333333333333"}},
+		Missing:     []Finding{{Type: "tree", OID: "4444444444444444444444444444444444444444"}},
+		Corrupt: []Finding{
+			{OID: "6666666666666666666666666666666666666666", Message: "object corrupt or missing: .git/objects/66/66666666666666666666666666666666666666"},
+			{Type: "commit", OID: "7777777777777777777777777777777777777777", Message: "missingAuthor: invalid format - expected 'author' line"},
+			{OID: "9999999999999999999999999999999999999999", Message: "hash mismatch for .git/objects/99/99999999999999999999999999999999999999 (expected 9999999999999999999999999999999999999999)"},
+		},
+		Warnings: []Finding{{Type: "tag", OID: "8888888888888888888888888888888888888888", Message: "missingTaggerEntry: invalid format - expected 'tagger' line"}},
+		Errors:   []string{"inflate: data stream error (incorrect header check)"},
+	}
+
+	if !reflect.DeepEqual(report, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", report, expected)
+	}
+
+	if report.OK() {
+		t.Fatal("the report must not be OK")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	for _, name := range []string{"a", "b"} {
+		repo.WriteFile(name, name)
+	}
+
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "init")
+
+	report, err := Check(context.Background(), global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(report, Report{}) {
+		t.Fatalf("Got: %+v, expected: no findings.", report)
+	}
+
+	repo.WriteFile("dangling.txt", "dangling")
+	dangling := repo.Git("hash-object", "-w", "dangling.txt")
+
+	missing := repo.Git("rev-parse", "HEAD:b")
+
+	err = os.Remove(filepath.Join(repo.Dir, ".git", "objects", missing[:2], missing[2:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = Check(context.Background(), global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := Report{
+		Dangling: []Finding{{Type: "blob", OID: dangling}},
+		Missing:  []Finding{{Type: "blob", OID: missing}},
+	}
+
+	if !reflect.DeepEqual(report, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", report, expected)
+	}
+
+	_, err = Check(context.Background(), global.UpperC(filepath.Join(repo.Dir, "unknown")))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,15 @@
+/*
+Package gc git-gc - Cleanup unnecessary files and optimize the local repository.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-gc
+
+	git gc [--aggressive] [--auto] [--quiet] [--prune=<date> | --no-prune] [--force] [--keep-largest-pack]
+
+# DESCRIPTION
+
+Runs a number of housekeeping tasks within the current repository, such as compressing file revisions (to reduce disk space and increase performance), removing unreachable objects which may have been created from prior invocations of git add, packing refs, pruning reflog, rerere metadata or stale working trees.
+May also update ancillary indexes such as the commit-graph.
+*/
+package gc
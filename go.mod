@@ -1,3 +1,3 @@
 module github.com/kumose-go/xgit
 
-go 1.19
+go 1.23
@@ -0,0 +1,45 @@
+package grep
+
+import (
+	"github.com/kumose-go/xgit/types"
+)
+
+// OpenGroup Starts a group of pattern expressions (ex: `-e a --and ( -e b --or -e c )`).
+// (
+func OpenGroup(g *types.Cmd) {
+	g.AddOptions("(")
+}
+
+// CloseGroup Ends a group of pattern expressions started by OpenGroup.
+// )
+func CloseGroup(g *types.Cmd) {
+	g.AddOptions(")")
+}
+
+// Trees Instead of searching tracked files in the working tree, search blobs in the given trees.
+// Must be used after the patterns.
+// [<tree>...]
+func Trees(trees ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, tree := range trees {
+			g.AddOptions(tree)
+		}
+	}
+}
+
+// PathSpecs If given, limit the search to paths matching at least one pattern.
+// Must be used after Trees.
+// [--] [<pathspec>...]
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		if len(pathSpecs) == 0 {
+			return
+		}
+
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
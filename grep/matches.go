@@ -0,0 +1,140 @@
+package grep
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// exitCodeNoMatch the exit code of `git grep` when nothing matches.
+const exitCodeNoMatch = 1
+
+// Match A matching line.
+type Match struct {
+	// Revision The tree in which the line was found (empty for the working tree and the index).
+	Revision string
+	Path     string
+	// Line The line number, starting at 1.
+	Line int
+	// Column The 1-indexed byte offset of the first match in the line.
+	Column int
+	// Text The content of the line.
+	Text string
+	// Binary True for a binary file: only the path is known.
+	Binary bool
+}
+
+// Matches Streams the lines matching the patterns (Pattern, PatternFile...) in the trees (the working tree if none), limited to the paths (if any),
+// using `git grep --null --line-number --column`.
+// No match is not an error: the sequence is empty.
+// Options changing the output (Count, FilesWithMatches, OnlyMatching, context lines...) are not supported.
+func Matches(ctx context.Context, trees, paths []string, options ...types.Option) iter.Seq2[Match, error] {
+	return func(yield func(Match, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("grep")
+		g.ApplyOptions(Null, LineNumber, Column, NoColor)
+		g.ApplyOptions(options...)
+		g.ApplyOptions(Trees(trees...), PathSpecs(paths...))
+		g.Env = append(g.Env, "LC_ALL=C")
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		for match, err := range ParseMatches(stdout, trees) {
+			if err != nil && types.ExitCode(err) == exitCodeNoMatch {
+				return
+			}
+
+			if !yield(match, err) {
+				return
+			}
+		}
+	}
+}
+
+// ParseMatches Streams the matches of a `git grep --null --line-number --column` output (with LC_ALL=C).
+// trees are the trees given to `git grep`, used to split the revision and the path.
+func ParseMatches(r io.Reader, trees []string) iter.Seq2[Match, error] {
+	// the longest tree first: a tree can be a prefix of another one.
+	trees = slices.Clone(trees)
+	slices.SortFunc(trees, func(a, b string) int { return len(b) - len(a) })
+
+	return func(yield func(Match, error) bool) {
+		reader := bufio.NewReader(r)
+
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+				if !errors.Is(err, io.EOF) {
+					yield(Match{}, err)
+				}
+
+				return
+			}
+
+			match, err := parseMatch(strings.TrimSuffix(line, "\n"), trees)
+			if !yield(match, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// parseMatch parses `<name> NUL <line> NUL <column> NUL <text>` or `Binary file <name> matches`.
+func parseMatch(line string, trees []string) (Match, error) {
+	if name, ok := strings.CutPrefix(line, "Binary file "); ok && strings.HasSuffix(name, " matches") && !strings.Contains(line, "\x00") {
+		revision, path := splitName(strings.TrimSuffix(name, " matches"), trees)
+
+		return Match{Revision: revision, Path: path, Binary: true}, nil
+	}
+
+	fields := strings.SplitN(line, "\x00", 4)
+	if len(fields) != 4 {
+		return Match{}, fmt.Errorf("invalid grep match: %q", line)
+	}
+
+	number, errLine := strconv.Atoi(fields[1])
+	column, errColumn := strconv.Atoi(fields[2])
+
+	if errLine != nil || errColumn != nil {
+		return Match{}, fmt.Errorf("invalid grep match: %q", line)
+	}
+
+	revision, path := splitName(fields[0], trees)
+
+	return Match{Revision: revision, Path: path, Line: number, Column: column, Text: fields[3]}, nil
+}
+
+// splitName splits `<tree>:<path>` (or `<tree>/<path>` when the tree is a `<rev>:<dir>`).
+func splitName(name string, trees []string) (string, string) {
+	for _, tree := range trees {
+		rest, ok := strings.CutPrefix(name, tree)
+		if !ok {
+			continue
+		}
+
+		if path, ok := strings.CutPrefix(rest, ":"); ok {
+			return tree, path
+		}
+
+		if path, ok := strings.CutPrefix(rest, "/"); ok {
+			return tree, path
+		}
+
+		if strings.HasSuffix(tree, ":") || strings.HasSuffix(tree, "/") {
+			return tree, rest
+		}
+	}
+
+	return "", name
+}
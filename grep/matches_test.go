@@ -0,0 +1,108 @@
+package grep
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestMatches(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "foo bar\nbaz\n")
+	repo.WriteFile("b.bin", "foo\x00bin\n")
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "init")
+	repo.WriteFile("a.txt", "Foo\nbar foo\n")
+	repo.Git("commit", "-q", "-am", "change")
+
+	ctx := context.Background()
+
+	collect := func(trees, paths []string, options ...types.Option) []Match {
+		t.Helper()
+
+		var matches []Match
+
+		for match, errMatch := range Matches(ctx, trees, paths, append([]types.Option{global.UpperC(repo.Dir)}, options...)...) {
+			if errMatch != nil {
+				t.Fatal(errMatch)
+			}
+
+			matches = append(matches, match)
+		}
+
+		return matches
+	}
+
+	matches := collect([]string{"HEAD~1", "HEAD"}, nil, Pattern("foo"))
+
+	expected := []Match{
+		{Revision: "HEAD~1", Path: "a.txt", Line: 1, Column: 1, Text: "foo bar"},
+		{Revision: "HEAD~1", Path: "b.bin", Binary: true},
+		{Revision: "HEAD", Path: "a.txt", Line: 2, Column: 5, Text: "bar foo"},
+		{Revision: "HEAD", Path: "b.bin", Binary: true},
+	}
+
+	if !reflect.DeepEqual(matches, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", matches, expected)
+	}
+
+	// the working tree, with a pattern expression.
+	matches = collect(nil, []string{"*.txt"}, IgnoreCase, Pattern("foo"), And, Not, Pattern("bar"))
+
+	expected = []Match{{Path: "a.txt", Line: 1, Column: 1, Text: "Foo"}}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", matches, expected)
+	}
+
+	// no match is not an error.
+	matches = collect([]string{"HEAD:"}, nil, FixedStrings, WordRegexp, Pattern("baz"))
+	if len(matches) != 0 {
+		t.Fatalf("unexpected matches: %+v", matches)
+	}
+
+	matches = collect([]string{"HEAD~1:"}, nil, FixedStrings, WordRegexp, Pattern("baz"))
+
+	expected = []Match{{Revision: "HEAD~1:", Path: "a.txt", Line: 2, Column: 1, Text: "baz"}}
+	if !reflect.DeepEqual(matches, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", matches, expected)
+	}
+
+	var errInvalid error
+
+	for _, errMatch := range Matches(ctx, nil, nil, global.UpperC(repo.Dir), ExtendedRegexp, Pattern("(")) {
+		errInvalid = errMatch
+	}
+
+	if errInvalid == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestParseMatches(t *testing.T) {
+	output := "HEAD:./:a.txt\x001\x003\x00x:y\nmain:sub/b.txt\x002\x001\x00z\n"
+
+	var matches []Match
+
+	for match, err := range ParseMatches(strings.NewReader(output), []string{"main", "HEAD:./"}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		matches = append(matches, match)
+	}
+
+	expected := []Match{
+		{Revision: "HEAD:./", Path: "a.txt", Line: 1, Column: 3, Text: "x:y"},
+		{Revision: "main", Path: "sub/b.txt", Line: 2, Column: 1, Text: "z"},
+	}
+
+	if !reflect.DeepEqual(matches, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", matches, expected)
+	}
+}
@@ -0,0 +1,84 @@
+package xgit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kumose-go/xgit/config"
+	"github.com/kumose-go/xgit/countobjects"
+	"github.com/kumose-go/xgit/fsck"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Default thresholds of `git gc --auto`.
+const (
+	defaultGCAuto          = 6700
+	defaultGCAutoPackLimit = 50
+)
+
+// HealthReport The health of a repository.
+type HealthReport struct {
+	// Objects The object statistics (`git count-objects -v`).
+	Objects countobjects.Stats
+	// Fsck The integrity findings (`git fsck`).
+	Fsck fsck.Report
+	// NeedsGC True when the loose objects or the packs exceed the thresholds of `git gc --auto` (gc.auto, gc.autoPackLimit).
+	// It's an approximation of the decision of git: git estimates the loose objects from a sample (`objects/17`),
+	// and does not count the kept packs (`.keep`), here all the loose objects and all the packs are counted.
+	NeedsGC bool
+}
+
+// OK Returns true if no missing or corrupt object was found.
+func (h HealthReport) OK() bool {
+	return h.Fsck.OK()
+}
+
+// Health Returns the health of the repository.
+// Health runs a full `git fsck`: every object is read and verified, it can take minutes on a large repository.
+// The options are the fsck options (ex: fsck.ConnectivityOnly to only check the connectivity, much faster).
+func (r *Repo) Health(ctx context.Context, options ...types.Option) (HealthReport, error) {
+	var (
+		report HealthReport
+		err    error
+	)
+
+	report.Objects, err = countobjects.StatsInfo(ctx, r.options...)
+	if err != nil {
+		return HealthReport{}, err
+	}
+
+	report.Fsck, err = fsck.Check(ctx, r.Options(options...)...)
+	if err != nil {
+		return HealthReport{}, err
+	}
+
+	snapshot, err := config.Load(ctx, r.options...)
+	if err != nil {
+		return HealthReport{}, err
+	}
+
+	gcAuto, err := intValue(snapshot, "gc.auto", defaultGCAuto)
+	if err != nil {
+		return HealthReport{}, err
+	}
+
+	packLimit, err := intValue(snapshot, "gc.autoPackLimit", defaultGCAutoPackLimit)
+	if err != nil {
+		return HealthReport{}, err
+	}
+
+	// a threshold lower than 1 disables the check.
+	report.NeedsGC = (gcAuto > 0 && report.Objects.Count > gcAuto) ||
+		(packLimit > 0 && report.Objects.Packs > packLimit)
+
+	return report, nil
+}
+
+func intValue(snapshot *config.Snapshot, key string, defaultValue int64) (int64, error) {
+	value, err := snapshot.Int(key)
+	if errors.Is(err, config.ErrNotFound) {
+		return defaultValue, nil
+	}
+
+	return value, err
+}
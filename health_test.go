@@ -0,0 +1,60 @@
+package xgit_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kumose-go/xgit"
+	"github.com/kumose-go/xgit/fsck"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestRepo_Health(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "a\n")
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "init")
+
+	r := xgit.NewRepo(repo.Dir)
+
+	report, err := r.Health(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a blob, a tree and a commit.
+	if !report.OK() || report.NeedsGC || report.Objects.Count != 3 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	repo.Git("config", "gc.auto", "2")
+
+	report, err = r.Health(context.Background(), fsck.ConnectivityOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.OK() || !report.NeedsGC {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+
+	// remove the blob.
+	blob := repo.Git("rev-parse", "HEAD:a.txt")
+
+	err = os.Remove(filepath.Join(repo.Dir, ".git", "objects", blob[:2], blob[2:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report, err = r.Health(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.OK() {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+}
@@ -17,6 +17,7 @@ import (
 
 type jsonModel struct {
 	CommandName string         `json:"command_name,omitempty"`
+	PackageName string         `json:"package_name,omitempty"`
 	Enabled     bool           `json:"enabled"`
 	Options     []jsonCmdModel `json:"options"`
 }
@@ -30,6 +31,7 @@ type jsonCmdModel struct {
 
 type genCmdModel struct {
 	Name      string
+	Package   string
 	ImportFMT bool
 	Metas     []cmdMeta
 }
@@ -53,7 +55,7 @@ func (r byMethodName) Less(i, j int) bool { return r[i].Method < r[j].Method }
 const (
 	fileTemplate = `// Code generated by pkg/commands/internal/migrate/cloner/cloner.go. DO NOT EDIT.
 
-package {{ Normalize .Name }}
+package {{ .Package }}
 
 {{if .ImportFMT }}import (
 	"fmt"
@@ -240,8 +242,14 @@ func generateFileContent(model genCmdModel) (string, error) {
 }
 
 func newGenCmdModel(jsonModel jsonModel) genCmdModel {
+	packageName := jsonModel.PackageName
+	if packageName == "" {
+		packageName = strings.ReplaceAll(jsonModel.CommandName, "-", "")
+	}
+
 	return genCmdModel{
 		Name:      jsonModel.CommandName,
+		Package:   packageName,
 		Metas:     jsonCmdModelToCmdMetas(jsonModel.Options),
 		ImportFMT: hasImportFMT(jsonModel.Options),
 	}
@@ -125,6 +125,36 @@ func Test_toGoName_should(t *testing.T) {
 	}
 }
 
+func Test_newGenCmdModel_packageName(t *testing.T) {
+	testCases := []struct {
+		name            string
+		model           jsonModel
+		expectedPackage string
+	}{
+		{
+			name:            "derive the package name from the command name",
+			model:           jsonModel{CommandName: "for-each-ref"},
+			expectedPackage: "foreachref",
+		},
+		{
+			name:            "use the explicit package name",
+			model:           jsonModel{CommandName: "switch", PackageName: "gswitch"},
+			expectedPackage: "gswitch",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			model := newGenCmdModel(test.model)
+
+			assertEquals(t, model.Package, test.expectedPackage)
+		})
+	}
+}
+
 func assertEquals(t *testing.T, value, expectedValue string) {
 	t.Helper()
 
@@ -0,0 +1,25 @@
+// Package gittest contains helpers for the tests of the command packages.
+package gittest
+
+import (
+	"context"
+	"io"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// StdoutExecutor Replaces the Git command call: the given output is written to the standard output of the command.
+func StdoutExecutor(output string) types.Option {
+	return func(g *types.Cmd) {
+		g.Executor = func(_ context.Context, _ string, _ bool, _ ...string) (string, error) {
+			_, err := io.WriteString(g.Stdout, output)
+			return "", err
+		}
+	}
+}
+
+// Translated Runs the command with a non-C locale (French messages, when the translations of git are installed).
+// C.UTF-8 is used for the messages category because it's available even when the French locale is not.
+func Translated(g *types.Cmd) {
+	g.Env = append(g.Env, "LANG=fr_FR.UTF-8", "LANGUAGE=fr", "LC_MESSAGES=C.UTF-8")
+}
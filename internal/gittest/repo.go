@@ -0,0 +1,117 @@
+package gittest
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Repo A Git repository of a test.
+type Repo struct {
+	t testing.TB
+	// Dir The directory where the commands run.
+	Dir string
+}
+
+// NewRepo Creates an empty repository (main as initial branch) in a temporary directory, removed at the end of the test.
+func NewRepo(t testing.TB) *Repo {
+	t.Helper()
+
+	repo := Open(t, TempDir(t))
+	repo.Git("init", "-q")
+
+	return repo
+}
+
+// Open Returns the repository in dir, without creating it.
+func Open(t testing.TB, dir string) *Repo {
+	t.Helper()
+
+	return &Repo{t: t, Dir: dir}
+}
+
+// TempDir Creates a temporary directory, removed at the end of the test.
+// The symbolic links of the path are resolved, so it can be compared with the paths returned by git.
+func TempDir(t testing.TB) string {
+	t.Helper()
+
+	//nolint:usetesting // Don't use `t.TempDir()` because of a bug with Windows on the CI
+	tmp, err := os.MkdirTemp("", "xgit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// clean up
+	t.Cleanup(func() {
+		errRm := os.RemoveAll(tmp)
+		if errRm != nil {
+			log.Println(errRm)
+		}
+	})
+
+	dir, err := filepath.EvalSymlinks(tmp)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+// Git Runs a git command in the repository as the test user (`test <test@example.com>`), and returns its trimmed output.
+// The test fails if the command fails.
+func (r *Repo) Git(args ...string) string {
+	r.t.Helper()
+
+	return r.run(nil, args)
+}
+
+// GitAs Runs a git command like Git, as another user.
+func (r *Repo) GitAs(name, email string, args ...string) string {
+	r.t.Helper()
+
+	return r.run(nil, append([]string{"-c", "user.name=" + name, "-c", "user.email=" + email}, args...))
+}
+
+// GitEnv Runs a git command like Git, with additional environment variables (ex: `GIT_COMMITTER_DATE`).
+func (r *Repo) GitEnv(env []string, args ...string) string {
+	r.t.Helper()
+
+	return r.run(env, args)
+}
+
+// WriteFile Writes a file of the repository, the missing parent directories are created.
+func (r *Repo) WriteFile(name, content string) {
+	r.t.Helper()
+
+	path := filepath.Join(r.Dir, name)
+
+	err := os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+
+	err = os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func (r *Repo) run(env, args []string) string {
+	r.t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-C", r.Dir, "-c", "user.name=test", "-c", "user.email=test@example.com", "-c", "init.defaultBranch=main"}, args...)...)
+
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.t.Fatal(string(out), err)
+	}
+
+	return strings.TrimSpace(string(out))
+}
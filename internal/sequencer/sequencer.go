@@ -0,0 +1,102 @@
+// Package sequencer contains the result handling shared by the commands driven by the git sequencer (cherry-pick, revert).
+package sequencer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kumose-go/xgit/lsfiles"
+	"github.com/kumose-go/xgit/types"
+)
+
+// exitCodeStopped the exit code of `git cherry-pick` and `git revert` when the sequencer stops (conflict, empty commit).
+const exitCodeStopped = 1
+
+// Stop Where the sequencer stopped.
+type Stop struct {
+	// Empty The commit became empty (nothing left to commit), otherwise the sequencer stopped on a conflict.
+	Empty bool
+	// Commit The commit on which the sequencer stopped (the head ref of the command), if known.
+	Commit string
+	// Conflicts The conflicted paths: empty when rerere resolved all the conflicts (`rerere.autoUpdate`).
+	Conflicts []string
+}
+
+// Run Runs a sequencer command (ex: `cherry-pick`) with the options, followed by the action (the commits, Continue, Skip).
+// headRef is the ref of the commit on which the sequencer stops (ex: `CHERRY_PICK_HEAD`).
+// The Stop is nil when all the commits have been applied.
+// A conflict or an empty commit is not an error: it's reported by the Stop.
+func Run(ctx context.Context, command, headRef string, action types.Option, options []types.Option) (*Stop, error) {
+	g := types.NewCmd(command)
+	g.ApplyOptions(options...)
+	g.ApplyOptions(action)
+
+	_, err := g.Output(ctx)
+	if err == nil {
+		return nil, nil
+	}
+
+	if types.ExitCode(err) != exitCodeStopped {
+		return nil, err
+	}
+
+	globalOptions := types.GlobalOptions(options...)
+
+	conflicts, errLs := lsfiles.ListConflicts(ctx, globalOptions)
+	if errLs != nil {
+		return nil, err
+	}
+
+	commit, _ := resolve(ctx, headRef, globalOptions)
+
+	stop := &Stop{Commit: commit}
+
+	for _, conflict := range conflicts {
+		stop.Conflicts = append(stop.Conflicts, conflict.Path)
+	}
+
+	if len(stop.Conflicts) > 0 {
+		return stop, nil
+	}
+
+	// nothing left to commit: the index matches HEAD.
+	if indexMatchesHEAD(ctx, globalOptions) {
+		stop.Empty = true
+		return stop, nil
+	}
+
+	// the conflicts have been resolved by rerere (`rerere.autoUpdate`): the sequencer is still stopped on the commit.
+	if commit == "" {
+		return nil, err
+	}
+
+	return stop, nil
+}
+
+// resolve returns the object name of a ref, using `git rev-parse --verify`.
+func resolve(ctx context.Context, ref string, options ...types.Option) (string, error) {
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(options...)
+	g.AddOptions("--verify")
+	g.AddOptions(ref)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(output), nil
+}
+
+func indexMatchesHEAD(ctx context.Context, option types.Option) bool {
+	g := types.NewCmd("diff-index")
+	g.ApplyOptions(option)
+	g.AddOptions("--cached")
+	g.AddOptions("--quiet")
+	g.AddOptions("HEAD")
+	g.AddOptions("--")
+
+	_, err := g.Output(ctx)
+
+	return err == nil
+}
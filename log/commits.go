@@ -0,0 +1,256 @@
+package log
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// commitFormat The fields of a commit, separated by NUL.
+// The trailers use the RS/US control characters as separators, and the `-z` option terminates the last field.
+const commitFormat = "--format=%H%x00%P%x00%an%x00%ae%x00%aI%x00%cn%x00%ce%x00%cI%x00%s%x00%b%x00" +
+	"%(trailers:only,unfold,separator=%x1e,key_value_separator=%x1f)"
+
+// commitFields the number of fields of commitFormat.
+const commitFields = 11
+
+// Commit A commit, as shown by Commits.
+type Commit struct {
+	Hash      string
+	Parents   []string
+	Author    Signature
+	Committer Signature
+	// Subject The title line of the message.
+	Subject string
+	// Body The message without the subject (trailers included).
+	Body     string
+	Trailers []Trailer
+	// Files The changed files, only set when the Numstat option is used.
+	Files []FileStat
+}
+
+// Signature The identity and date of an author or a committer.
+type Signature struct {
+	Name  string
+	Email string
+	When  time.Time
+}
+
+// Trailer A trailer of a commit message (ex: `Signed-off-by: John Doe <john@example.com>`).
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+// FileStat The number of added and deleted lines of a file, as shown by `--numstat`.
+type FileStat struct {
+	Path string
+	// OldPath The path before a rename or a copy.
+	OldPath string
+	Added   int
+	Deleted int
+	// Binary True for a binary file (no line counts).
+	Binary bool
+}
+
+// Commits Streams the commits using `git log -z` with a NUL-safe format.
+// The Numstat option fills Commit.Files.
+// Options changing the output (Format, Oneline, Patch, Graph...) are not supported.
+func Commits(ctx context.Context, options ...types.Option) iter.Seq2[Commit, error] {
+	return func(yield func(Commit, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("log")
+		g.AddOptions("-z")
+		g.AddOptions(commitFormat)
+		g.ApplyOptions(options...)
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		reader := &commitReader{r: bufio.NewReader(stdout)}
+
+		for {
+			commit, err := reader.next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(Commit{}, err)
+				return
+			}
+
+			if !yield(commit, nil) {
+				return
+			}
+		}
+	}
+}
+
+type commitReader struct {
+	r *bufio.Reader
+	// pending a token read ahead.
+	pending *string
+}
+
+// next reads the fields of a commit, followed by its optional `--numstat` records.
+func (c *commitReader) next() (Commit, error) {
+	fields := make([]string, commitFields)
+
+	for i := range fields {
+		field, err := c.token()
+		if errors.Is(err, io.EOF) && i > 0 {
+			return Commit{}, fmt.Errorf("truncated commit: %q", fields[:i])
+		}
+
+		if err != nil {
+			return Commit{}, err
+		}
+
+		fields[i] = field
+	}
+
+	commit, err := parseCommit(fields)
+	if err != nil {
+		return Commit{}, err
+	}
+
+	for {
+		record, err := c.token()
+		if errors.Is(err, io.EOF) {
+			return commit, nil
+		}
+
+		if err != nil {
+			return Commit{}, err
+		}
+
+		stat, ok := parseFileStat(strings.TrimPrefix(record, "\n"))
+		if !ok {
+			c.pending = &record
+			return commit, nil
+		}
+
+		if stat.Path == "" {
+			// rename or copy: `<added> TAB <deleted> TAB NUL <old path> NUL <new path> NUL`
+			stat.OldPath, err = c.token()
+			if err == nil {
+				stat.Path, err = c.token()
+			}
+
+			if err != nil {
+				return Commit{}, fmt.Errorf("truncated numstat record: %w", err)
+			}
+		}
+
+		commit.Files = append(commit.Files, stat)
+	}
+}
+
+// token reads a NUL terminated token.
+func (c *commitReader) token() (string, error) {
+	if c.pending != nil {
+		token := *c.pending
+		c.pending = nil
+
+		return token, nil
+	}
+
+	token, err := c.r.ReadString(0)
+	if err != nil {
+		if errors.Is(err, io.EOF) && token != "" {
+			return "", fmt.Errorf("truncated record: %q", token)
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSuffix(token, "\x00"), nil
+}
+
+func parseCommit(fields []string) (Commit, error) {
+	author, err := parseSignature(fields[2], fields[3], fields[4])
+	if err != nil {
+		return Commit{}, err
+	}
+
+	committer, err := parseSignature(fields[5], fields[6], fields[7])
+	if err != nil {
+		return Commit{}, err
+	}
+
+	var parents []string
+	if fields[1] != "" {
+		parents = strings.Split(fields[1], " ")
+	}
+
+	return Commit{
+		Hash:      fields[0],
+		Parents:   parents,
+		Author:    author,
+		Committer: committer,
+		Subject:   fields[8],
+		Body:      strings.TrimRight(fields[9], "\n"),
+		Trailers:  parseTrailers(fields[10]),
+	}, nil
+}
+
+func parseSignature(name, email, date string) (Signature, error) {
+	when, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return Signature{}, fmt.Errorf("invalid date %q: %w", date, err)
+	}
+
+	return Signature{Name: name, Email: email, When: when}, nil
+}
+
+// parseTrailers parses `<key> US <value> [RS <key> US <value>]...`.
+func parseTrailers(raw string) []Trailer {
+	if raw == "" {
+		return nil
+	}
+
+	var trailers []Trailer
+
+	for _, item := range strings.Split(raw, "\x1e") {
+		key, value, _ := strings.Cut(item, "\x1f")
+
+		trailers = append(trailers, Trailer{Key: key, Value: strings.TrimSpace(value)})
+	}
+
+	return trailers
+}
+
+// parseFileStat parses `<added> TAB <deleted> TAB <path>` (`-` for the counts of a binary file).
+func parseFileStat(record string) (FileStat, bool) {
+	parts := strings.SplitN(record, "\t", 3)
+	if len(parts) != 3 {
+		return FileStat{}, false
+	}
+
+	if parts[0] == "-" && parts[1] == "-" {
+		return FileStat{Path: parts[2], Binary: true}, true
+	}
+
+	added, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return FileStat{}, false
+	}
+
+	deleted, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return FileStat{}, false
+	}
+
+	return FileStat{Path: parts[2], Added: added, Deleted: deleted}, true
+}
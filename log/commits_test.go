@@ -0,0 +1,131 @@
+package log
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestCommits(t *testing.T) {
+	output := "2222222222222222222222222222222222222222\x001111111111111111111111111111111111111111\x00" +
+		"John Doe\x00john@example.com\x002024-03-31T12:00:00+02:00\x00" +
+		"Jane Doe\x00jane@example.com\x002024-04-01T08:30:00Z\x00" +
+		"feat: rename\x00\x00\x00" +
+		"\n1\t0\t\x00a\x00b\x00-\t-\tbin\x00" +
+		"1111111111111111111111111111111111111111\x00\x00" +
+		"John Doe\x00john@example.com\x002024-03-30T12:00:00+02:00\x00" +
+		"John Doe\x00john@example.com\x002024-03-30T12:00:00+02:00\x00" +
+		"first\x00body line\n\nSigned-off-by: John Doe <john@example.com>\n\x00" +
+		"Signed-off-by\x1fJohn Doe <john@example.com>\x00" +
+		"\n3\t0\tsp ace\x00"
+
+	var commits []Commit
+
+	for commit, err := range Commits(context.Background(), gittest.StdoutExecutor(output)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		commits = append(commits, commit)
+	}
+
+	expected := []Commit{
+		{
+			Hash:      "2222222222222222222222222222222222222222",
+			Parents:   []string{"1111111111111111111111111111111111111111"},
+			Author:    Signature{Name: "John Doe", Email: "john@example.com", When: time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC)},
+			Committer: Signature{Name: "Jane Doe", Email: "jane@example.com", When: time.Date(2024, 4, 1, 8, 30, 0, 0, time.UTC)},
+			Subject:   "feat: rename",
+			Files: []FileStat{
+				{Path: "b", OldPath: "a", Added: 1},
+				{Path: "bin", Binary: true},
+			},
+		},
+		{
+			Hash:      "1111111111111111111111111111111111111111",
+			Author:    Signature{Name: "John Doe", Email: "john@example.com", When: time.Date(2024, 3, 30, 10, 0, 0, 0, time.UTC)},
+			Committer: Signature{Name: "John Doe", Email: "john@example.com", When: time.Date(2024, 3, 30, 10, 0, 0, 0, time.UTC)},
+			Subject:   "first",
+			Body:      "body line\n\nSigned-off-by: John Doe <john@example.com>",
+			Trailers:  []Trailer{{Key: "Signed-off-by", Value: "John Doe <john@example.com>"}},
+			Files:     []FileStat{{Path: "sp ace", Added: 3}},
+		},
+	}
+
+	if len(commits) != len(expected) {
+		t.Fatalf("Got: %d commits, expected: %d.", len(commits), len(expected))
+	}
+
+	for i, commit := range commits {
+		if !commit.Author.When.Equal(expected[i].Author.When) || !commit.Committer.When.Equal(expected[i].Committer.When) {
+			t.Fatalf("Got: %v %v, expected: %v %v.", commit.Author.When, commit.Committer.When, expected[i].Author.When, expected[i].Committer.When)
+		}
+
+		commit.Author.When = expected[i].Author.When
+		commit.Committer.When = expected[i].Committer.When
+
+		if !reflect.DeepEqual(commit, expected[i]) {
+			t.Fatalf("Got: %+v, expected: %+v.", commit, expected[i])
+		}
+	}
+}
+
+func TestCommits_truncated(t *testing.T) {
+	var errs error
+
+	for _, err := range Commits(context.Background(), gittest.StdoutExecutor("2222222222222222222222222222222222222222\x00\x00John")) {
+		if err != nil {
+			errs = err
+			break
+		}
+	}
+
+	if errs == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestCommits_git(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "a\nb\n")
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "first", "-m", "Details.", "--trailer", "Reviewed-by: Jane")
+	repo.Git("mv", "a.txt", "b.txt")
+	repo.Git("commit", "-q", "-m", "rename")
+
+	var commits []Commit
+
+	for commit, err := range Commits(context.Background(), global.UpperC(repo.Dir), Numstat, FindRenames(""), MaxCount("5"), PathSpecs(".")) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		commits = append(commits, commit)
+	}
+
+	if len(commits) != 2 {
+		t.Fatalf("Got: %d commits, expected: 2.", len(commits))
+	}
+
+	if commits[0].Subject != "rename" || !reflect.DeepEqual(commits[0].Parents, []string{commits[1].Hash}) {
+		t.Fatalf("unexpected commit: %+v", commits[0])
+	}
+
+	if !reflect.DeepEqual(commits[0].Files, []FileStat{{Path: "b.txt", OldPath: "a.txt"}}) {
+		t.Fatalf("unexpected files: %+v", commits[0].Files)
+	}
+
+	expected := []Trailer{{Key: "Reviewed-by", Value: "Jane"}}
+	if commits[1].Body != "Details.\n\nReviewed-by: Jane" || !reflect.DeepEqual(commits[1].Trailers, expected) {
+		t.Fatalf("unexpected message: %q %+v", commits[1].Body, commits[1].Trailers)
+	}
+
+	if commits[1].Author.Email != "test@example.com" || commits[1].Author.When.IsZero() {
+		t.Fatalf("unexpected author: %+v", commits[1].Author)
+	}
+}
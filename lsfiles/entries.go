@@ -0,0 +1,312 @@
+package lsfiles
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Stage numbers of an index entry.
+const (
+	// StageMerged A normal, merged, entry.
+	StageMerged = iota
+	// StageBase The common ancestor version of an unmerged path.
+	StageBase
+	// StageOurs The "ours" version of an unmerged path.
+	StageOurs
+	// StageTheirs The "theirs" version of an unmerged path.
+	StageTheirs
+)
+
+// Entry An index entry, as shown by `git ls-files --stage`.
+type Entry struct {
+	Mode   string
+	Object string
+	Stage  int
+	Path   string
+	// EOL End-of-line information, only set when the Eol option is used.
+	EOL *EOL
+	// Stat Cached stat data, only set when the Debug option is used.
+	Stat *Stat
+}
+
+// EOL End-of-line information of an entry (`i/<eolinfo> w/<eolinfo> attr/<eolattr>`).
+type EOL struct {
+	Index    string
+	WorkTree string
+	Attr     string
+}
+
+// Stat Stat data cached in the index for an entry.
+type Stat struct {
+	CTime time.Time
+	MTime time.Time
+	Dev   uint32
+	Ino   uint32
+	UID   uint32
+	GID   uint32
+	Size  uint32
+	Flags uint32
+}
+
+// Conflict The stages of an unmerged path.
+// A stage is nil when the path does not exist on that side.
+type Conflict struct {
+	Path   string
+	Base   *Entry
+	Ours   *Entry
+	Theirs *Entry
+}
+
+// Entries Streams the index entries using `git ls-files --stage -z`.
+// The Eol and Debug options fill Entry.EOL and Entry.Stat.
+func Entries(ctx context.Context, options ...types.Option) iter.Seq2[Entry, error] {
+	return func(yield func(Entry, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("ls-files")
+		g.AddOptions("--stage")
+		g.AddOptions("-z")
+		g.ApplyOptions(options...)
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		reader := &entryReader{r: bufio.NewReader(stdout), eol: slices.Contains(g.Options, "--eol")}
+
+		for {
+			entry, err := reader.next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				yield(Entry{}, err)
+				return
+			}
+
+			if !yield(entry, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ListConflicts Returns the unmerged paths using `git ls-files --unmerged`.
+func ListConflicts(ctx context.Context, options ...types.Option) ([]Conflict, error) {
+	var entries []Entry
+
+	for entry, err := range Entries(ctx, append(options, Unmerged)...) {
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return Conflicts(entries), nil
+}
+
+// Conflicts Groups the unmerged entries by path, in order of appearance.
+// Merged entries (stage 0) are ignored.
+func Conflicts(entries []Entry) []Conflict {
+	var conflicts []Conflict
+
+	index := map[string]int{}
+
+	for _, entry := range entries {
+		if entry.Stage == StageMerged {
+			continue
+		}
+
+		i, ok := index[entry.Path]
+		if !ok {
+			i = len(conflicts)
+			index[entry.Path] = i
+
+			conflicts = append(conflicts, Conflict{Path: entry.Path})
+		}
+
+		e := entry
+
+		switch entry.Stage {
+		case StageBase:
+			conflicts[i].Base = &e
+		case StageOurs:
+			conflicts[i].Ours = &e
+		case StageTheirs:
+			conflicts[i].Theirs = &e
+		}
+	}
+
+	return conflicts
+}
+
+type entryReader struct {
+	r   *bufio.Reader
+	eol bool
+}
+
+// next reads one `-z` record, followed by its optional `--debug` lines.
+func (e *entryReader) next() (Entry, error) {
+	record, err := e.r.ReadString(0)
+	if err != nil {
+		if errors.Is(err, io.EOF) && record != "" {
+			return Entry{}, fmt.Errorf("truncated entry: %q", record)
+		}
+
+		return Entry{}, err
+	}
+
+	entry, err := parseEntry(strings.TrimSuffix(record, "\x00"), e.eol)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	for {
+		prefix, err := e.r.Peek(2)
+		if err != nil || !bytes.Equal(prefix, []byte("  ")) {
+			return entry, nil
+		}
+
+		line, err := e.r.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return Entry{}, err
+		}
+
+		if entry.Stat == nil {
+			entry.Stat = &Stat{}
+		}
+
+		err = parseStatLine(entry.Stat, line)
+		if err != nil {
+			return Entry{}, err
+		}
+	}
+}
+
+// parseEntry parses `<mode> SP <object> SP <stage> TAB [<eol> TAB] <file>`.
+func parseEntry(record string, eol bool) (Entry, error) {
+	head, rest, ok := strings.Cut(record, "\t")
+	if !ok {
+		return Entry{}, fmt.Errorf("invalid entry: %q", record)
+	}
+
+	fields := strings.Fields(head)
+	if len(fields) != 3 {
+		return Entry{}, fmt.Errorf("invalid entry: %q", record)
+	}
+
+	stage, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return Entry{}, fmt.Errorf("invalid stage: %q", record)
+	}
+
+	entry := Entry{
+		Mode:   fields[0],
+		Object: fields[1],
+		Stage:  stage,
+		Path:   rest,
+	}
+
+	if eol {
+		info, path, found := strings.Cut(rest, "\t")
+		if !found {
+			return Entry{}, fmt.Errorf("invalid eol info: %q", record)
+		}
+
+		entry.Path = path
+		entry.EOL = parseEOL(info)
+	}
+
+	return entry, nil
+}
+
+// parseEOL parses `i/<eolinfo> w/<eolinfo> attr/<eolattr>`, the attribute can contain spaces.
+func parseEOL(info string) *EOL {
+	eol := &EOL{}
+
+	rest := strings.TrimPrefix(info, "i/")
+
+	index, rest, _ := strings.Cut(rest, "w/")
+	eol.Index = strings.TrimSpace(index)
+
+	workTree, attr, _ := strings.Cut(rest, "attr/")
+	eol.WorkTree = strings.TrimSpace(workTree)
+	eol.Attr = strings.TrimSpace(attr)
+
+	return eol
+}
+
+// parseStatLine parses one `--debug` line (ex: `  dev: 2049	ino: 1234`).
+func parseStatLine(stat *Stat, line string) error {
+	for _, field := range strings.Split(strings.TrimSpace(line), "\t") {
+		key, value, ok := strings.Cut(field, ": ")
+		if !ok {
+			return fmt.Errorf("invalid debug line: %q", line)
+		}
+
+		var err error
+
+		switch key {
+		case "ctime":
+			stat.CTime, err = parseStatTime(value)
+		case "mtime":
+			stat.MTime, err = parseStatTime(value)
+		case "dev":
+			stat.Dev, err = parseUint32(value, 10)
+		case "ino":
+			stat.Ino, err = parseUint32(value, 10)
+		case "uid":
+			stat.UID, err = parseUint32(value, 10)
+		case "gid":
+			stat.GID, err = parseUint32(value, 10)
+		case "size":
+			stat.Size, err = parseUint32(value, 10)
+		case "flags":
+			stat.Flags, err = parseUint32(value, 16)
+		}
+
+		if err != nil {
+			return fmt.Errorf("invalid debug line: %q: %w", line, err)
+		}
+	}
+
+	return nil
+}
+
+func parseStatTime(value string) (time.Time, error) {
+	sec, nsec, _ := strings.Cut(value, ":")
+
+	s, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var ns int64
+	if nsec != "" {
+		ns, err = strconv.ParseInt(nsec, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return time.Unix(s, ns), nil
+}
+
+func parseUint32(value string, base int) (uint32, error) {
+	v, err := strconv.ParseUint(value, base, 32)
+
+	return uint32(v), err
+}
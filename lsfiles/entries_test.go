@@ -0,0 +1,125 @@
+package lsfiles
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestEntries(t *testing.T) {
+	testCases := []struct {
+		name     string
+		options  []types.Option
+		output   string
+		expected []Entry
+	}{
+		{
+			name:   "stage",
+			output: "100644 78981922613b2afb6025042ff6bd878ac1994e85 0\ta.txt\x00100755 485540d7ad7473f697234cebe0b55016c5dc1b40 0\tsp ace\t.sh\x00",
+			expected: []Entry{
+				{Mode: "100644", Object: "78981922613b2afb6025042ff6bd878ac1994e85", Stage: StageMerged, Path: "a.txt"},
+				{Mode: "100755", Object: "485540d7ad7473f697234cebe0b55016c5dc1b40", Stage: StageMerged, Path: "sp ace\t.sh"},
+			},
+		},
+		{
+			name:    "eol",
+			options: []types.Option{Eol},
+			output:  "100644 78981922613b2afb6025042ff6bd878ac1994e85 0\ti/lf    w/crlf  attr/text eol=crlf    \ta.txt\x00",
+			expected: []Entry{
+				{
+					Mode: "100644", Object: "78981922613b2afb6025042ff6bd878ac1994e85", Stage: StageMerged, Path: "a.txt",
+					EOL: &EOL{Index: "lf", WorkTree: "crlf", Attr: "text eol=crlf"},
+				},
+			},
+		},
+		{
+			name:    "debug",
+			options: []types.Option{Debug},
+			output: "100644 78981922613b2afb6025042ff6bd878ac1994e85 0\ta.txt\x00" +
+				"  ctime: 1700000000:12\n  mtime: 1700000001:0\n  dev: 65024\tino: 9617857\n  uid: 1000\tgid: 100\n  size: 2\tflags: 1000\n" +
+				"100644 485540d7ad7473f697234cebe0b55016c5dc1b40 0\tb.txt\x00" +
+				"  ctime: 1700000000:12\n  mtime: 1700000001:0\n  dev: 65024\tino: 9617858\n  uid: 1000\tgid: 100\n  size: 3\tflags: 0\n",
+			expected: []Entry{
+				{
+					Mode: "100644", Object: "78981922613b2afb6025042ff6bd878ac1994e85", Stage: StageMerged, Path: "a.txt",
+					Stat: &Stat{
+						CTime: time.Unix(1700000000, 12), MTime: time.Unix(1700000001, 0),
+						Dev: 65024, Ino: 9617857, UID: 1000, GID: 100, Size: 2, Flags: 0x1000,
+					},
+				},
+				{
+					Mode: "100644", Object: "485540d7ad7473f697234cebe0b55016c5dc1b40", Stage: StageMerged, Path: "b.txt",
+					Stat: &Stat{
+						CTime: time.Unix(1700000000, 12), MTime: time.Unix(1700000001, 0),
+						Dev: 65024, Ino: 9617858, UID: 1000, GID: 100, Size: 3, Flags: 0,
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			var entries []Entry
+
+			for entry, err := range Entries(context.Background(), append(test.options, gittest.StdoutExecutor(test.output))...) {
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				entries = append(entries, entry)
+			}
+
+			if !reflect.DeepEqual(entries, test.expected) {
+				t.Fatalf("Got: %+v, expected: %+v.", entries, test.expected)
+			}
+		})
+	}
+}
+
+func TestListConflicts(t *testing.T) {
+	output := "100644 1111111111111111111111111111111111111111 1\tconflict.go\x00" +
+		"100644 2222222222222222222222222222222222222222 2\tconflict.go\x00" +
+		"100644 3333333333333333333333333333333333333333 3\tconflict.go\x00" +
+		"100644 4444444444444444444444444444444444444444 2\tadded-by-us.go\x00"
+
+	conflicts, err := ListConflicts(context.Background(), gittest.StdoutExecutor(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Conflict{
+		{
+			Path:   "conflict.go",
+			Base:   &Entry{Mode: "100644", Object: "1111111111111111111111111111111111111111", Stage: StageBase, Path: "conflict.go"},
+			Ours:   &Entry{Mode: "100644", Object: "2222222222222222222222222222222222222222", Stage: StageOurs, Path: "conflict.go"},
+			Theirs: &Entry{Mode: "100644", Object: "3333333333333333333333333333333333333333", Stage: StageTheirs, Path: "conflict.go"},
+		},
+		{
+			Path: "added-by-us.go",
+			Ours: &Entry{Mode: "100644", Object: "4444444444444444444444444444444444444444", Stage: StageOurs, Path: "added-by-us.go"},
+		},
+	}
+
+	if !reflect.DeepEqual(conflicts, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", conflicts, expected)
+	}
+}
+
+func TestEntries_error(t *testing.T) {
+	var got error
+
+	for _, err := range Entries(context.Background(), gittest.StdoutExecutor("100644 78981922613b2afb6025042ff6bd878ac1994e85 0\ta.txt")) {
+		got = err
+		break
+	}
+
+	if got == nil || !strings.Contains(got.Error(), "truncated entry") {
+		t.Fatalf("Got: %v, expected a truncated entry error.", got)
+	}
+}
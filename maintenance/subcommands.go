@@ -0,0 +1,50 @@
+package maintenance
+
+import "github.com/kumose-go/xgit/types"
+
+// Tasks of `git maintenance run` (see Task).
+const (
+	TaskGC                = "gc"
+	TaskCommitGraph       = "commit-graph"
+	TaskPrefetch          = "prefetch"
+	TaskLooseObjects      = "loose-objects"
+	TaskIncrementalRepack = "incremental-repack"
+	TaskPackRefs          = "pack-refs"
+)
+
+// Run one or more maintenance tasks (the enabled tasks if no Task is given).
+// usage: git maintenance run [--auto] [--schedule=<frequency>] [--quiet] [--task=<task>...]
+func Run(options ...types.Option) types.Option {
+	return subCommand("run", options)
+}
+
+// Start Register the repository and start the background maintenance.
+// usage: git maintenance start [--scheduler=<scheduler>]
+func Start(options ...types.Option) types.Option {
+	return subCommand("start", options)
+}
+
+// Stop the background maintenance: the registered repositories are kept.
+// usage: git maintenance stop
+func Stop() types.Option {
+	return subCommand("stop", nil)
+}
+
+// Register the repository for the background maintenance (maintenance.repo in the global configuration).
+// usage: git maintenance register
+func Register(options ...types.Option) types.Option {
+	return subCommand("register", options)
+}
+
+// Unregister the repository from the background maintenance.
+// usage: git maintenance unregister
+func Unregister(options ...types.Option) types.Option {
+	return subCommand("unregister", options)
+}
+
+func subCommand(name string, options []types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(name)
+		g.ApplyOptions(options...)
+	}
+}
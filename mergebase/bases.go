@@ -0,0 +1,70 @@
+package mergebase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// exitCodeNotFound the exit code of `git merge-base` when there is no result
+// (no common ancestor, not an ancestor, no fork point).
+const exitCodeNotFound = 1
+
+// Find Returns the OIDs printed by `git merge-base` for the commits:
+// the best common ancestor, all of them with All, the n-way merge bases with Octopus,
+// or the commits that cannot be reached from any other with Independent.
+// Returns an empty slice when the commits have no common ancestor.
+func Find(ctx context.Context, commits []string, options ...types.Option) ([]string, error) {
+	g := types.NewCmd("merge-base")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Commits(commits...))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		if types.ExitCode(err) == exitCodeNotFound && strings.TrimSpace(output) == "" {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return strings.Fields(output), nil
+}
+
+// FindForkPoint Returns the point at which commit (HEAD if empty) forked from ref,
+// taking into account the reflog of ref, using `git merge-base --fork-point`.
+// Returns an empty string when no fork point is found.
+func FindForkPoint(ctx context.Context, ref, commit string, options ...types.Option) (string, error) {
+	commits := []string{ref}
+	if commit != "" {
+		commits = append(commits, commit)
+	}
+
+	oids, err := Find(ctx, commits, append([]types.Option{ForkPoint}, options...)...)
+	if err != nil || len(oids) == 0 {
+		return "", err
+	}
+
+	return oids[0], nil
+}
+
+// IsAncestorOf Returns true if the commit a is an ancestor of the commit b (or the same commit),
+// using `git merge-base --is-ancestor`.
+func IsAncestorOf(ctx context.Context, a, b string, options ...types.Option) (bool, error) {
+	g := types.NewCmd("merge-base")
+	g.ApplyOptions(IsAncestor)
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Commits(a, b))
+
+	_, err := g.Output(ctx)
+	if err == nil {
+		return true, nil
+	}
+
+	if types.ExitCode(err) == exitCodeNotFound {
+		return false, nil
+	}
+
+	return false, err
+}
@@ -0,0 +1,102 @@
+package mergebase
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestFind(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.Git("commit", "-q", "--allow-empty", "-m", "init")
+	base := repo.Git("rev-parse", "HEAD")
+	repo.Git("checkout", "-q", "-b", "feature")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "feature")
+	feature := repo.Git("rev-parse", "HEAD")
+	repo.Git("checkout", "-q", "main")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "main")
+	main := repo.Git("rev-parse", "HEAD")
+	repo.Git("checkout", "-q", "--orphan", "orphan")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "orphan")
+
+	ctx := context.Background()
+	opt := global.UpperC(repo.Dir)
+
+	oids, err := Find(ctx, []string{"main", "feature"}, opt, All)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(oids, []string{base}) {
+		t.Fatalf("Got: %v, expected: %v.", oids, []string{base})
+	}
+
+	oids, err = Find(ctx, []string{"main", "feature", base}, opt, Independent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(oids)
+
+	expected := []string{feature, main}
+	sort.Strings(expected)
+
+	if !reflect.DeepEqual(oids, expected) {
+		t.Fatalf("Got: %v, expected: %v.", oids, expected)
+	}
+
+	oids, err = Find(ctx, []string{"main", "orphan"}, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(oids) != 0 {
+		t.Fatalf("Got: %v, expected no merge base.", oids)
+	}
+
+	_, err = Find(ctx, []string{"main", "unknown"}, opt)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	ok, err := IsAncestorOf(ctx, base, "feature", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ok {
+		t.Fatalf("%s must be an ancestor of feature", base)
+	}
+
+	ok, err = IsAncestorOf(ctx, "main", "feature", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok {
+		t.Fatal("main must not be an ancestor of feature")
+	}
+
+	forkPoint, err := FindForkPoint(ctx, "main", "feature", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if forkPoint != base {
+		t.Fatalf("Got: %s, expected: %s.", forkPoint, base)
+	}
+
+	forkPoint, err = FindForkPoint(ctx, "main", "orphan", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if forkPoint != "" {
+		t.Fatalf("Got: %s, expected no fork point.", forkPoint)
+	}
+}
@@ -0,0 +1,18 @@
+/*
+Package mergebase git-merge-base - Find as good common ancestors as possible for a merge.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-merge-base
+
+	git merge-base [-a | --all] <commit> <commit>…​
+	git merge-base [-a | --all] --octopus <commit>…​
+	git merge-base --is-ancestor <commit> <commit>
+	git merge-base --independent <commit>…​
+	git merge-base --fork-point <ref> [<commit>]
+
+# DESCRIPTION
+
+git merge-base finds best common ancestor(s) between two commits to use in a three-way merge. One common ancestor is better than another common ancestor if the latter is an ancestor of the former. A common ancestor that does not have any better common ancestor is a best common ancestor, i.e. a merge base. Note that there can be more than one merge base for a pair of commits.
+*/
+package mergebase
@@ -0,0 +1,13 @@
+package mergebase
+
+import "github.com/kumose-go/xgit/types"
+
+// Commits <commit>...
+// The commits (or the <ref> followed by the optional <commit> with ForkPoint).
+func Commits(commits ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, commit := range commits {
+			g.AddOptions(commit)
+		}
+	}
+}
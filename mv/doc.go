@@ -0,0 +1,19 @@
+/*
+Package mv git-mv - Move or rename a file, a directory, or a symlink.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-mv
+
+	git mv [<options>] <source> <destination>
+	git mv [<options>] <source>…​ <destination>
+
+# DESCRIPTION
+
+Move or rename a file, directory or symlink.
+
+In the first form, it renames <source>, which must exist and be either a file, symlink or directory, to <destination>. In the second form, the last argument has to be an existing directory; the given sources will be moved into this directory.
+
+The index is updated after successful completion, but the change must still be committed.
+*/
+package mv
@@ -0,0 +1,22 @@
+package mv
+
+import "github.com/kumose-go/xgit/types"
+
+// Sources <source>...
+// The files, directories or symlinks to move.
+func Sources(sources ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, source := range sources {
+			g.AddOptions(source)
+		}
+	}
+}
+
+// Destination <destination>
+// The new name of the source, or the existing directory where the sources are moved.
+// Must be the last option.
+func Destination(destination string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(destination)
+	}
+}
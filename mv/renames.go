@@ -0,0 +1,66 @@
+package mv
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Rename A move of a file, a directory or a symlink.
+type Rename struct {
+	Source      string
+	Destination string
+}
+
+// Parse Returns the moves from the output of `git mv --dry-run` with LC_ALL=C.
+// Each move is announced by `Checking rename of '<source>' to '<destination>'` and done by `Renaming <source> to <destination>`:
+// the moves skipped with SkipErrors have no `Renaming` line.
+func Parse(output string) []Rename {
+	var (
+		checked []Rename
+		renamed = map[string]bool{}
+	)
+
+	for _, line := range strings.Split(output, "\n") {
+		if check, found := strings.CutPrefix(line, "Checking rename of '"); found {
+			source, destination, ok := strings.Cut(strings.TrimSuffix(check, "'"), "' to '")
+			if ok {
+				checked = append(checked, Rename{Source: source, Destination: destination})
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(line, "Renaming ") {
+			renamed[line] = true
+		}
+	}
+
+	var renames []Rename
+
+	for _, rename := range checked {
+		if renamed["Renaming "+rename.Source+" to "+rename.Destination] {
+			renames = append(renames, rename)
+		}
+	}
+
+	return renames
+}
+
+// Preview Returns the moves that would be done, using `git mv --dry-run`.
+// The options must contain the Sources and the Destination.
+// The command runs with LC_ALL=C: the messages are parsed untranslated.
+func Preview(ctx context.Context, options ...types.Option) ([]Rename, error) {
+	g := types.NewCmd("mv")
+	g.ApplyOptions(DryRun)
+	g.ApplyOptions(options...)
+	g.Env = append(g.Env, "LC_ALL=C")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(output), nil
+}
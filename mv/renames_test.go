@@ -0,0 +1,66 @@
+package mv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestParse(t *testing.T) {
+	output := "Checking rename of 'a' to 'dir/a'\nChecking rename of 'unknown' to 'dir/unknown'\nChecking rename of 'b to c' to 'dir/b to c'\nRenaming a to dir/a\nRenaming b to c to dir/b to c\n"
+
+	renames := Parse(output)
+
+	expected := []Rename{
+		{Source: "a", Destination: "dir/a"},
+		{Source: "b to c", Destination: "dir/b to c"},
+	}
+
+	if !reflect.DeepEqual(renames, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", renames, expected)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	for _, name := range []string{"a.txt", "b.txt", "docs/.keep"} {
+		repo.WriteFile(name, name)
+	}
+
+	repo.Git("add", ".")
+
+	renames, err := Preview(context.Background(), global.UpperC(repo.Dir), SkipErrors, Sources("a.txt", "unknown.txt", "b.txt"), Destination("docs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Rename{
+		{Source: "a.txt", Destination: "docs/a.txt"},
+		{Source: "b.txt", Destination: "docs/b.txt"},
+	}
+
+	if !reflect.DeepEqual(renames, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", renames, expected)
+	}
+
+	// the messages are parsed in the C locale.
+	renames, err = Preview(context.Background(), global.UpperC(repo.Dir), gittest.Translated, Sources("a.txt", "b.txt"), Destination("docs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(renames, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", renames, expected)
+	}
+
+	_, err = os.Stat(filepath.Join(repo.Dir, "a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
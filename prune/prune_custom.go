@@ -0,0 +1,19 @@
+package prune
+
+import "github.com/kumose-go/xgit/types"
+
+// Heads In addition to objects reachable from any of our references, keep objects reachable from listed <head>s.
+// [--] [<head>...]
+func Heads(heads ...string) types.Option {
+	return func(g *types.Cmd) {
+		if len(heads) == 0 {
+			return
+		}
+
+		g.AddOptions("--")
+
+		for _, head := range heads {
+			g.AddOptions(head)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Package reflog git-reflog - Manage reflog information.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-reflog
+
+	git reflog [show] [<log-options>] [<ref>]
+	git reflog expire [--expire=<time>] [--expire-unreachable=<time>]
+		[--rewrite] [--updateref] [--stale-fix]
+		[--dry-run | -n] [--verbose] [--all [--single-worktree] | <refs>...]
+	git reflog delete [--rewrite] [--updateref]
+		[--dry-run | -n] [--verbose] <ref>@{<specifier>}...
+	git reflog exists <ref>
+
+# DESCRIPTION
+
+This command manages the information recorded in the reflogs.
+
+Reference logs, or "reflogs", record when the tips of branches and other references were updated in the local repository.
+Reflogs are useful in various Git commands, to specify the old value of a reference.
+For example, HEAD@{2} means "where HEAD used to be two moves ago", master@{one.week.ago} means "where master used to point to one week ago in this local repository", and so on.
+*/
+package reflog
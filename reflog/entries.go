@@ -0,0 +1,267 @@
+package reflog
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// selectorFormat The selector (`<ref>@{<index>}`) and the new value of an entry, separated by NUL.
+const selectorFormat = "--format=%gD%x00%H"
+
+// Identity The identity of the user who updated the ref.
+type Identity struct {
+	Name  string
+	Email string
+}
+
+// Entry An entry of a reflog.
+type Entry struct {
+	// Old The value of the ref before the update (the zero object name when the ref was created).
+	Old string
+	// New The value of the ref after the update.
+	New string
+	// Index The position of the entry in the reflog, newest first (`<ref>@{<index>}`).
+	Index    int
+	Identity Identity
+	// When The date of the update.
+	When time.Time
+	// Action The kind of update (ex: `checkout`, `commit (amend)`, `reset`, `pull`).
+	Action string
+	// Message The description of the update (ex: `moving from main to feature`).
+	Message string
+}
+
+// Entries Streams the entries of the reflog of a ref (HEAD if empty), newest first.
+// The entries are selected with `git reflog show`, and read from the reflog file (`$GIT_DIR/logs/<ref>`):
+// the options are the global options, and the `git log` options limiting the entries (ex: `log.MaxCount("10")`, `log.GrepReflog("checkout")`).
+// Options changing the format are not supported.
+// Only the reflogs of the files backend are supported: the reftable backend has no reflog file.
+func Entries(ctx context.Context, ref string, options ...types.Option) iter.Seq2[Entry, error] {
+	return func(yield func(Entry, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("reflog")
+		g.ApplyOptions(Show(ref, append([]types.Option{func(g *types.Cmd) {
+			g.AddOptions(selectorFormat)
+		}}, options...)...))
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		reader := bufio.NewReader(stdout)
+
+		// the reflog file is read once the first entry is selected.
+		var entries []Entry
+
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+				if !errors.Is(err, io.EOF) {
+					yield(Entry{}, err)
+				}
+
+				return
+			}
+
+			if entries == nil {
+				entries, err = readLog(ctx, ref, options)
+				if err != nil {
+					yield(Entry{}, err)
+					return
+				}
+			}
+
+			entry, err := selectEntry(entries, strings.TrimSuffix(line, "\n"))
+			if err != nil {
+				yield(Entry{}, err)
+				return
+			}
+
+			if !yield(entry, nil) {
+				return
+			}
+		}
+	}
+}
+
+// ParseEntries Parses the content of a reflog file (`$GIT_DIR/logs/<ref>`): the entries are returned newest first.
+func ParseEntries(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+
+	reader := bufio.NewReader(r)
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+			if !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+
+			break
+		}
+
+		entry, err := parseEntry(strings.TrimSuffix(line, "\n"))
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	slices.Reverse(entries)
+
+	for i := range entries {
+		entries[i].Index = i
+	}
+
+	return entries, nil
+}
+
+// readLog reads the reflog file of a ref.
+func readLog(ctx context.Context, ref string, options []types.Option) ([]Entry, error) {
+	name, err := fullName(ctx, ref, options)
+	if err != nil {
+		return nil, err
+	}
+
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(types.GlobalOptions(options...))
+	g.AddOptions("--path-format=absolute")
+	g.AddOptions("--git-path")
+	g.AddOptions("logs/" + name)
+
+	path, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(strings.TrimSpace(path))
+	if err != nil {
+		return nil, fmt.Errorf("reflog of %s: %w", name, err)
+	}
+
+	defer func() { _ = file.Close() }()
+
+	return ParseEntries(file)
+}
+
+// fullName returns the full name of a ref (ex: `refs/heads/main` for `main`), HEAD is kept as is.
+func fullName(ctx context.Context, ref string, options []types.Option) (string, error) {
+	if ref == "" || ref == "HEAD" {
+		return "HEAD", nil
+	}
+
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(types.GlobalOptions(options...))
+	g.AddOptions("--symbolic-full-name")
+	g.AddOptions(ref)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.TrimSpace(output)
+	if name == "" {
+		return "", fmt.Errorf("reflog of %s: not a ref", ref)
+	}
+
+	return name, nil
+}
+
+// selectEntry returns the entry selected by a line of the `git reflog show` output (see selectorFormat).
+func selectEntry(entries []Entry, line string) (Entry, error) {
+	selector, value, found := strings.Cut(line, "\x00")
+	if !found {
+		return Entry{}, fmt.Errorf("invalid reflog selector: %q", line)
+	}
+
+	i := strings.LastIndex(selector, "@{")
+	if i < 0 || !strings.HasSuffix(selector, "}") {
+		return Entry{}, fmt.Errorf("invalid reflog selector: %q", selector)
+	}
+
+	index, err := strconv.Atoi(selector[i+2 : len(selector)-1])
+	if err != nil {
+		return Entry{}, fmt.Errorf("invalid reflog selector: %q", selector)
+	}
+
+	// the reflog may have been updated between the walk and the read of the file.
+	if index >= len(entries) || entries[index].New != value {
+		return Entry{}, fmt.Errorf("reflog entry %s: not found in the reflog file", selector)
+	}
+
+	return entries[index], nil
+}
+
+// parseEntry parses a line of a reflog file: `<old> <new> <name> <<email>> <timestamp> <zone>\t<message>`.
+func parseEntry(line string) (Entry, error) {
+	header, message, _ := strings.Cut(line, "\t")
+
+	fields := strings.SplitN(header, " ", 3)
+	if len(fields) != 3 {
+		return Entry{}, fmt.Errorf("invalid reflog entry: %q", line)
+	}
+
+	start := strings.Index(fields[2], "<")
+	end := strings.LastIndex(fields[2], ">")
+
+	if start < 0 || end < start {
+		return Entry{}, fmt.Errorf("invalid reflog entry: %q", line)
+	}
+
+	timestamp, zone, _ := strings.Cut(strings.TrimSpace(fields[2][end+1:]), " ")
+
+	when, err := parseDate(timestamp, zone)
+	if err != nil {
+		return Entry{}, fmt.Errorf("invalid reflog entry: %q", line)
+	}
+
+	entry := Entry{
+		Old:      fields[0],
+		New:      fields[1],
+		Identity: Identity{Name: strings.TrimSpace(fields[2][:start]), Email: fields[2][start+1 : end]},
+		When:     when,
+		Message:  message,
+	}
+
+	if action, msg, found := strings.Cut(message, ": "); found {
+		entry.Action, entry.Message = action, msg
+	}
+
+	return entry, nil
+}
+
+// parseDate parses a raw date: `<timestamp> <zone>`.
+func parseDate(timestamp, zone string) (time.Time, error) {
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(zone) != 5 || (zone[0] != '+' && zone[0] != '-') {
+		return time.Time{}, fmt.Errorf("invalid date: %q %q", timestamp, zone)
+	}
+
+	hours, errH := strconv.Atoi(zone[1:3])
+	minutes, errM := strconv.Atoi(zone[3:])
+
+	if errH != nil || errM != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %q %q", timestamp, zone)
+	}
+
+	offset := hours*3600 + minutes*60
+	if zone[0] == '-' {
+		offset = -offset
+	}
+
+	return time.Unix(sec, 0).In(time.FixedZone(zone, offset)), nil
+}
@@ -0,0 +1,218 @@
+package reflog
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	xlog "github.com/kumose-go/xgit/log"
+)
+
+func TestParseEntries(t *testing.T) {
+	content := "0000000000000000000000000000000000000000 1111111111111111111111111111111111111111 Jane <jane@example.com> 1700000000 -0130\tcommit (initial): init\n" +
+		"1111111111111111111111111111111111111111 2222222222222222222222222222222222222222 John <john@example.com> 1700000200 +0000\tcheckout: moving from feature to main\n"
+
+	entries, err := ParseEntries(strings.NewReader(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry{
+		{
+			Old:      "1111111111111111111111111111111111111111",
+			New:      "2222222222222222222222222222222222222222",
+			Identity: Identity{Name: "John", Email: "john@example.com"},
+			When:     time.Unix(1700000200, 0).In(time.FixedZone("+0000", 0)),
+			Action:   "checkout",
+			Message:  "moving from feature to main",
+		},
+		{
+			Old:      "0000000000000000000000000000000000000000",
+			New:      "1111111111111111111111111111111111111111",
+			Index:    1,
+			Identity: Identity{Name: "Jane", Email: "jane@example.com"},
+			When:     time.Unix(1700000000, 0).In(time.FixedZone("-0130", -90*60)),
+			Action:   "commit (initial)",
+			Message:  "init",
+		},
+	}
+
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	_, err = ParseEntries(strings.NewReader("1111111111111111111111111111111111111111 init\n"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestEntries(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	// the reflog uses the committer date.
+	git := func(date string, args ...string) string {
+		t.Helper()
+
+		return repo.GitEnv([]string{"GIT_COMMITTER_DATE=" + date, "GIT_AUTHOR_DATE=" + date}, args...)
+	}
+
+	git("1700000000 +0000", "commit", "-q", "--allow-empty", "-m", "init")
+	initial := git("", "rev-parse", "HEAD")
+	zero := strings.Repeat("0", len(initial))
+	git("1700000600 +0000", "checkout", "-q", "-b", "feature")
+	git("1700001200 +0000", "commit", "-q", "--allow-empty", "-m", "feature")
+	feature := git("", "rev-parse", "HEAD")
+	git("1700001800 +0000", "checkout", "-q", "main")
+	git("1700002400 +0000", "checkout", "-q", "--detach", feature)
+	git("1700003000 +0000", "checkout", "-q", "-b", "fix")
+
+	var entries []Entry
+
+	for entry, err := range Entries(context.Background(), "HEAD", global.UpperC(repo.Dir), xlog.MaxCount("3")) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("Got: %d entries, expected: 3.", len(entries))
+	}
+
+	expected := Entry{
+		Old:      feature,
+		New:      feature,
+		Identity: Identity{Name: "test", Email: "test@example.com"},
+		Action:   "checkout",
+		Message:  "moving from " + feature + " to fix",
+	}
+
+	if !entries[0].When.Equal(time.Unix(1700003000, 0)) {
+		t.Fatalf("Got: %s, expected: 1700003000.", entries[0].When)
+	}
+
+	entries[0].When = time.Time{}
+
+	if entries[0] != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", entries[0], expected)
+	}
+
+	// the oldest returned entry is not the oldest of the reflog.
+	if entries[2].Old != feature || entries[2].New != initial || entries[2].Index != 2 || !entries[2].When.Equal(time.Unix(1700001800, 0)) {
+		t.Fatalf("unexpected oldest entry: %+v", entries[2])
+	}
+
+	// Old, Index and When are read from the whole reflog.
+	entries = nil
+
+	for entry, errEntry := range Entries(context.Background(), "HEAD", global.UpperC(repo.Dir), xlog.GrepReflog("^commit")) {
+		if errEntry != nil {
+			t.Fatal(errEntry)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	expectedEntries := []Entry{
+		{Old: initial, New: feature, Index: 3, Action: "commit", Message: "feature"},
+		{Old: zero, New: initial, Index: 5, Action: "commit (initial)", Message: "init"},
+	}
+
+	if len(entries) != len(expectedEntries) {
+		t.Fatalf("Got: %d entries, expected: %d.", len(entries), len(expectedEntries))
+	}
+
+	for i, entry := range entries {
+		if !entry.When.Equal(time.Unix(1700001200-int64(i)*1200, 0)) {
+			t.Fatalf("Got: %s, expected: %d.", entry.When, 1700001200-i*1200)
+		}
+
+		entry.When = time.Time{}
+		entry.Identity = Identity{}
+
+		if entry != expectedEntries[i] {
+			t.Fatalf("Got: %+v, expected: %+v.", entry, expectedEntries[i])
+		}
+	}
+
+	// the old values are kept when an entry is deleted.
+	git("", "reflog", "delete", "HEAD@{1}")
+
+	entries = nil
+
+	for entry, errEntry := range Entries(context.Background(), "", global.UpperC(repo.Dir), xlog.MaxCount("2")) {
+		if errEntry != nil {
+			t.Fatal(errEntry)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 2 || entries[0].Old != feature || entries[1].Old != feature || entries[1].New != initial || entries[1].Index != 1 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	// a short ref name.
+	entries = nil
+
+	for entry, errEntry := range Entries(context.Background(), "feature", global.UpperC(repo.Dir)) {
+		if errEntry != nil {
+			t.Fatal(errEntry)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(entries) != 2 || entries[0].Old != initial || entries[0].New != feature || entries[1].Old != zero || entries[1].Index != 1 {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	branches, err := RecentBranches(context.Background(), 0, global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(branches, []string{"fix", "main", "feature"}) {
+		t.Fatalf("Got: %+v, expected: [fix main feature].", branches)
+	}
+
+	branches, err = RecentBranches(context.Background(), 2, global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(branches, []string{"fix", "main"}) {
+		t.Fatalf("Got: %+v, expected: [fix main].", branches)
+	}
+
+	value, err := At(context.Background(), "HEAD", time.Unix(1700001500, 0), global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value != feature {
+		t.Fatalf("Got: %s, expected: %s.", value, feature)
+	}
+
+	_, err = At(context.Background(), "feature", time.Unix(1600000000, 0), global.UpperC(repo.Dir))
+	if !errors.Is(err, ErrNoEntry) {
+		t.Fatalf("Got: %v, expected: %v.", err, ErrNoEntry)
+	}
+
+	exists, err := HasLog(context.Background(), "refs/heads/feature", global.UpperC(repo.Dir))
+	if err != nil || !exists {
+		t.Fatalf("Got: %v, %v, expected: true.", exists, err)
+	}
+
+	exists, err = HasLog(context.Background(), "refs/heads/unknown", global.UpperC(repo.Dir))
+	if err != nil || exists {
+		t.Fatalf("Got: %v, %v, expected: false.", exists, err)
+	}
+}
@@ -0,0 +1,101 @@
+package reflog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// ErrNoEntry The reflog does not go back to the requested date.
+var ErrNoEntry = errors.New("no reflog entry")
+
+var expHash = regexp.MustCompile(`^[0-9a-f]{40,64}$`)
+
+// HasLog Returns true if the ref has a reflog, using `git reflog exists`.
+func HasLog(ctx context.Context, ref string, options ...types.Option) (bool, error) {
+	g := types.NewCmd("reflog")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Exists(ref))
+
+	_, err := g.Output(ctx)
+	if types.ExitCode(err) == 1 {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RecentBranches Returns the recently checked out branches, most recent first, from the checkouts recorded in the reflog of HEAD.
+// The detached HEADs are ignored, the deleted branches are kept. A limit lower than 1 returns all the branches.
+func RecentBranches(ctx context.Context, limit int, options ...types.Option) ([]string, error) {
+	var branches []string
+
+	seen := map[string]bool{}
+
+	for entry, err := range Entries(ctx, "HEAD", options...) {
+		if err != nil {
+			return nil, err
+		}
+
+		from, to, found := strings.Cut(strings.TrimPrefix(entry.Message, "moving from "), " to ")
+		if entry.Action != "checkout" || !found {
+			continue
+		}
+
+		for _, branch := range []string{to, from} {
+			if seen[branch] || expHash.MatchString(branch) {
+				continue
+			}
+
+			seen[branch] = true
+			branches = append(branches, branch)
+
+			if len(branches) == limit {
+				return branches, nil
+			}
+		}
+	}
+
+	return branches, nil
+}
+
+// At Returns the value of a ref (HEAD if empty) at the given date, from its reflog.
+// Returns ErrNoEntry if the reflog does not go back to this date.
+func At(ctx context.Context, ref string, date time.Time, options ...types.Option) (string, error) {
+	var value string
+
+	for entry, err := range Entries(ctx, ref, options...) {
+		if err != nil {
+			return "", err
+		}
+
+		if entry.When.After(date) {
+			continue
+		}
+
+		value = entry.New
+
+		break
+	}
+
+	if value == "" {
+		return "", fmt.Errorf("%s at %s: %w", ref, date.Format(time.RFC3339), ErrNoEntry)
+	}
+
+	return value, nil
+}
+
+// Ago Returns the value of a ref (HEAD if empty) the given duration ago (ex: `reflog.Ago(ctx, "main", 30*time.Minute)`).
+// Returns ErrNoEntry if the reflog does not go back to this date.
+func Ago(ctx context.Context, ref string, duration time.Duration, options ...types.Option) (string, error) {
+	return At(ctx, ref, time.Now().Add(-duration), options...)
+}
@@ -0,0 +1,46 @@
+package reflog
+
+import "github.com/kumose-go/xgit/types"
+
+// Show the reflog of a ref (HEAD if empty).
+// The options are the options of `git log` (ex: `log.MaxCount("10")`).
+// usage: git reflog [show] [<log-options>] [<ref>]
+func Show(ref string, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("show")
+		g.ApplyOptions(options...)
+
+		if ref != "" {
+			g.AddOptions(ref)
+		}
+	}
+}
+
+// Expire Prune the old entries of the reflogs of the refs (use All for all the refs).
+// usage: git reflog expire [--expire=<time>] [--expire-unreachable=<time>] [--rewrite] [--updateref] [--stale-fix] [--dry-run | -n] [--verbose] [--all [--single-worktree] | <refs>...]
+func Expire(refs []string, options ...types.Option) types.Option {
+	return subCommand("expire", refs, options)
+}
+
+// Delete the given entries (ex: `HEAD@{2}`).
+// usage: git reflog delete [--rewrite] [--updateref] [--dry-run | -n] [--verbose] <ref>@{<specifier>}...
+func Delete(selectors []string, options ...types.Option) types.Option {
+	return subCommand("delete", selectors, options)
+}
+
+// Exists Check whether a ref has a reflog: exits with a non-zero status if it does not.
+// usage: git reflog exists <ref>
+func Exists(ref string) types.Option {
+	return subCommand("exists", []string{ref}, nil)
+}
+
+func subCommand(name string, args []string, options []types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(name)
+		g.ApplyOptions(options...)
+
+		for _, arg := range args {
+			g.AddOptions(arg)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+/*
+Package repack git-repack - Pack unpacked objects in a repository.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-repack
+
+	git repack [-a] [-A] [-d] [-f] [-F] [-l] [-n] [-q] [-b] [-m]
+		[--window=<n>] [--depth=<n>] [--threads=<n>] [--keep-pack=<pack-name>]
+		[--write-midx] [--cruft] [--cruft-expiration=<approxidate>] [--geometric=<factor>]
+
+# DESCRIPTION
+
+This command is used to combine all objects that do not currently reside in a "pack", into a pack.
+It can also be used to re-organize existing packs into a single, more efficient pack.
+
+A pack is a collection of objects, individually compressed, with delta compression applied, stored in a single file, with an associated index file.
+
+Packs are used to reduce the load on mirror systems, backup engines, disk storage, etc.
+*/
+package repack
@@ -0,0 +1,81 @@
+package xgit
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/kumose-go/xgit/catfile"
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/sparsecheckout"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Repo A repository: the commands are run with the same global options (directory, executor, environment...),
+// and the long-lived processes are shared.
+type Repo struct {
+	dir     string
+	options []types.Option
+
+	mu      sync.Mutex
+	catFile *catfile.Session
+}
+
+// NewRepo Creates a repository from its directory (`git -C <dir>`, the current directory if empty) and global options.
+func NewRepo(dir string, options ...types.Option) *Repo {
+	var repoOptions []types.Option
+	if dir != "" {
+		repoOptions = append(repoOptions, global.UpperC(dir))
+	}
+
+	return &Repo{dir: dir, options: append(repoOptions, options...)}
+}
+
+// Dir Returns the directory of the repository.
+func (r *Repo) Dir() string {
+	return r.dir
+}
+
+// Options Returns the global options of the repository, followed by the given options.
+// Useful to call the helpers of the command packages (ex: `lsfiles.Entries(ctx, repo.Options()...)`).
+func (r *Repo) Options(options ...types.Option) []types.Option {
+	return slices.Concat(r.options, options)
+}
+
+// Command Executes a git command in the repository.
+func (r *Repo) Command(ctx context.Context, name string, options ...types.Option) (string, error) {
+	return command(ctx, name, r.Options(options...)...)
+}
+
+// CatFile Returns the `git cat-file --batch-command` session of the repository.
+// The session is shared and safe for concurrent use, it is stopped by Close.
+func (r *Repo) CatFile() *catfile.Session {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.catFile == nil {
+		r.catFile = catfile.NewSession(catfile.ModeBatchCommand, r.options...)
+	}
+
+	return r.catFile
+}
+
+// SparseInfo Returns the sparse-checkout settings of the repository: sparse-checkout, cone mode and sparse index.
+func (r *Repo) SparseInfo(ctx context.Context) (sparsecheckout.Info, error) {
+	return sparsecheckout.ReadInfo(ctx, r.options...)
+}
+
+// Close Stops the long-lived processes of the repository.
+func (r *Repo) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.catFile == nil {
+		return nil
+	}
+
+	err := r.catFile.Close()
+	r.catFile = nil
+
+	return err
+}
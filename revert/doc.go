@@ -0,0 +1,17 @@
+/*
+Package revert git-revert - Revert some existing commits.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-revert
+
+	git revert [--[no-]edit] [-n] [-m <parent-number>] [-s] [-S[<keyid>]] <commit>…​
+	git revert (--continue | --skip | --abort | --quit)
+
+# DESCRIPTION
+
+Given one or more existing commits, revert the changes that the related patches introduce, and record some new commits that record them. This requires your working tree to be clean (no modifications from the HEAD commit).
+
+Note: git revert is used to record some new commits to reverse the effect of some earlier commits (often only a faulty one). If you want to throw away all uncommitted changes in your working directory, you should see git-reset(1), particularly the --hard option. If you want to extract specific files as they were in another commit, you should see git-restore(1), specifically the --source option. Take care with these alternatives as both will discard uncommitted changes in your working directory.
+*/
+package revert
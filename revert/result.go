@@ -0,0 +1,79 @@
+package revert
+
+import (
+	"context"
+
+	"github.com/kumose-go/xgit/internal/sequencer"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Status The outcome of a revert.
+type Status int
+
+// Statuses of a revert.
+const (
+	// StatusCompleted All the commits have been reverted.
+	StatusCompleted Status = iota
+	// StatusConflict The sequencer stopped on a conflict (possibly resolved by rerere): resolve it, then use ContinueInfo (or SkipInfo, Abort, Quit).
+	StatusConflict
+	// StatusEmpty The revert of a commit is empty: use SkipInfo to continue with the rest of the sequence.
+	StatusEmpty
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusCompleted:
+		return "completed"
+	case StatusConflict:
+		return "conflict"
+	case StatusEmpty:
+		return "empty"
+	default:
+		return "unknown"
+	}
+}
+
+// Result The result of a revert.
+type Result struct {
+	Status Status
+	// Commit The commit on which the sequencer stopped (REVERT_HEAD), if known.
+	Commit string
+	// Conflicts The conflicted paths (empty when rerere resolved all the conflicts, see `rerere.autoUpdate`).
+	Conflicts []string
+}
+
+// RevertInfo Reverts the commits, using `git revert`.
+// A conflict or an empty commit is not an error: it's reported by the Result.
+func RevertInfo(ctx context.Context, commits []string, options ...types.Option) (*Result, error) {
+	return run(ctx, Commits(commits...), options)
+}
+
+// ContinueInfo Continues the revert in progress after the resolution of the conflicts, using `git revert --continue`.
+// The sequencer can stop again on another commit.
+func ContinueInfo(ctx context.Context, options ...types.Option) (*Result, error) {
+	return run(ctx, Continue, options)
+}
+
+// SkipInfo Skips the current commit and continues with the rest of the sequence, using `git revert --skip`.
+// The sequencer can stop again on another commit.
+func SkipInfo(ctx context.Context, options ...types.Option) (*Result, error) {
+	return run(ctx, Skip, options)
+}
+
+func run(ctx context.Context, action types.Option, options []types.Option) (*Result, error) {
+	stop, err := sequencer.Run(ctx, "revert", "REVERT_HEAD", action, options)
+	if err != nil {
+		return nil, err
+	}
+
+	if stop == nil {
+		return &Result{Status: StatusCompleted}, nil
+	}
+
+	result := &Result{Status: StatusConflict, Commit: stop.Commit, Conflicts: stop.Conflicts}
+	if stop.Empty {
+		result.Status = StatusEmpty
+	}
+
+	return result, nil
+}
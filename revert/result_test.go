@@ -0,0 +1,67 @@
+package revert
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestRevertInfo(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "1\n")
+	repo.Git("add", "a.txt")
+	repo.Git("commit", "-q", "-m", "one")
+	repo.WriteFile("a.txt", "2\n")
+	repo.Git("commit", "-q", "-am", "two")
+	two := repo.Git("rev-parse", "HEAD")
+	repo.WriteFile("a.txt", "3\n")
+	repo.Git("commit", "-q", "-am", "three")
+
+	ctx := context.Background()
+	options := []types.Option{
+		global.UpperC(repo.Dir),
+		global.LowerC("user.name", "test"),
+		global.LowerC("user.email", "test@example.com"),
+	}
+
+	result, err := RevertInfo(ctx, []string{"HEAD"}, append(options, NoEdit)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusCompleted {
+		t.Fatalf("Got: %s, expected: %s.", result.Status, StatusCompleted)
+	}
+
+	repo.WriteFile("a.txt", "3\n")
+	repo.Git("commit", "-q", "-am", "three again")
+
+	// `three again` changed the lines reverted by `two`.
+
+	result, err = RevertInfo(ctx, []string{two}, append(options, NoEdit)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Result{Status: StatusConflict, Commit: two, Conflicts: []string{"a.txt"}}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	repo.Git("revert", "--abort")
+
+	// `three again` already reverts the revert of `three`.
+	result, err = RevertInfo(ctx, []string{"HEAD~1"}, append(options, NoEdit)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Status != StatusEmpty {
+		t.Fatalf("Got: %s, expected: %s.", result.Status, StatusEmpty)
+	}
+}
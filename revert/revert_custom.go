@@ -0,0 +1,13 @@
+package revert
+
+import "github.com/kumose-go/xgit/types"
+
+// Commits <commit>...
+// Commits to revert (ex: `HEAD~3`, `main~5..main~2`).
+func Commits(commits ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, commit := range commits {
+			g.AddOptions(commit)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+/*
+Package revlist git-rev-list - Lists commit objects in reverse chronological order.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-rev-list
+
+	git rev-list [<options>] <commit>…​ [--] [<path>…​]
+
+# DESCRIPTION
+
+List commits that are reachable by following the parent links from the given commit(s), but exclude commits that are reachable from the one(s) given with a ^ in front of them. The output is given in reverse chronological order by default.
+
+You can think of this as a set operation. Commits reachable from any of the commits given on the command line form a set, and then commits reachable from any of the ones given with ^ in front are subtracted from that set. The remaining commits are what comes out in the command’s output. Various other options and paths parameters can be used to further limit the result.
+*/
+package revlist
@@ -0,0 +1,134 @@
+package revlist
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/mergebase"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Commit A commit listed by `git rev-list --parents`.
+type Commit struct {
+	Hash    string
+	Parents []string
+	// Boundary True for an excluded boundary commit (Boundary option).
+	Boundary bool
+}
+
+// AheadBehind Returns the number of commits of a that are not in b (ahead),
+// and the number of commits of b that are not in a (behind), using `git rev-list --left-right --count a...b`.
+// The options can limit the counted commits (ex: FirstParent, NoMerges, CherryPick).
+func AheadBehind(ctx context.Context, a, b string, options ...types.Option) (int, int, error) {
+	g := types.NewCmd("rev-list")
+	g.ApplyOptions(LeftRight, Count, Revisions(a+"..."+b))
+	g.ApplyOptions(options...)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	left, right, found := strings.Cut(strings.TrimSpace(output), "\t")
+	if !found {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+
+	ahead, errAhead := strconv.Atoi(left)
+	behind, errBehind := strconv.Atoi(right)
+
+	if errAhead != nil || errBehind != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+
+	return ahead, behind, nil
+}
+
+// CountCommits Returns the number of commits of a revision range (ex: `v1.0..HEAD`, `HEAD`),
+// using `git rev-list --count`.
+func CountCommits(ctx context.Context, revisionRange string, options ...types.Option) (int, error) {
+	g := types.NewCmd("rev-list")
+	g.ApplyOptions(Count, Revisions(revisionRange))
+	g.ApplyOptions(options...)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+
+	return count, nil
+}
+
+// Ancestors Streams the commits reachable from a revision (the revision included), with their parents,
+// using `git rev-list --parents`.
+// The options can limit or order the commits (ex: MaxCount, FirstParent, TopoOrder, Revisions("^main")),
+// options changing the output format (Count, LeftRight, Objects, Timestamp...) are not supported.
+func Ancestors(ctx context.Context, revision string, options ...types.Option) iter.Seq2[Commit, error] {
+	return func(yield func(Commit, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		g := types.NewCmd("rev-list")
+		g.ApplyOptions(Parents, Revisions(revision))
+		g.ApplyOptions(options...)
+
+		stdout := g.Pipe(ctx)
+		defer func() { _ = stdout.Close() }()
+
+		reader := bufio.NewReader(stdout)
+
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+				if !errors.Is(err, io.EOF) {
+					yield(Commit{}, err)
+				}
+
+				return
+			}
+
+			commit, err := parseCommit(strings.TrimSuffix(line, "\n"))
+			if !yield(commit, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// IsAncestor Returns true if the commit a is an ancestor of the commit b (or the same commit),
+// using `git merge-base --is-ancestor`.
+// Only the global options (directory, executor...) are used.
+func IsAncestor(ctx context.Context, a, b string, options ...types.Option) (bool, error) {
+	return mergebase.IsAncestorOf(ctx, a, b, types.GlobalOptions(options...))
+}
+
+// parseCommit parses `[-]<hash> [<parent>...]`.
+func parseCommit(line string) (Commit, error) {
+	var commit Commit
+
+	line, commit.Boundary = strings.CutPrefix(line, "-")
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return Commit{}, fmt.Errorf("unexpected rev-list line: %q", line)
+	}
+
+	commit.Hash = fields[0]
+
+	if len(fields) > 1 {
+		commit.Parents = fields[1:]
+	}
+
+	return commit, nil
+}
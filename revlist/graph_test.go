@@ -0,0 +1,123 @@
+package revlist
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestGraph(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.Git("commit", "-q", "--allow-empty", "-m", "init")
+	root := repo.Git("rev-parse", "HEAD")
+	repo.Git("checkout", "-q", "-b", "feature")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "feature 1")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "feature 2")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "feature 3")
+	feature := repo.Git("rev-parse", "HEAD")
+	repo.Git("checkout", "-q", "main")
+	repo.Git("commit", "-q", "--allow-empty", "-m", "main 1")
+	main := repo.Git("rev-parse", "HEAD")
+	repo.Git("merge", "-q", "--no-ff", "-m", "merge", "feature")
+	merge := repo.Git("rev-parse", "HEAD")
+
+	ctx := context.Background()
+	opt := global.UpperC(repo.Dir)
+
+	ahead, behind, err := AheadBehind(ctx, "feature", main, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ahead != 3 || behind != 1 {
+		t.Fatalf("Got: ahead %d, behind %d, expected: ahead 3, behind 1.", ahead, behind)
+	}
+
+	count, err := CountCommits(ctx, "HEAD", opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 6 {
+		t.Fatalf("Got: %d, expected: 6.", count)
+	}
+
+	count, err = CountCommits(ctx, "HEAD", opt, FirstParent)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 3 {
+		t.Fatalf("Got: %d, expected: 3.", count)
+	}
+
+	var commits []Commit
+
+	for commit, errCommit := range Ancestors(ctx, "HEAD", opt, FirstParent, TopoOrder) {
+		if errCommit != nil {
+			t.Fatal(errCommit)
+		}
+
+		commits = append(commits, commit)
+	}
+
+	expected := []Commit{
+		{Hash: merge, Parents: []string{main, feature}},
+		{Hash: main, Parents: []string{root}},
+		{Hash: root},
+	}
+
+	if !reflect.DeepEqual(commits, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", commits, expected)
+	}
+
+	ok, err := IsAncestor(ctx, root, feature, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !ok {
+		t.Fatalf("%s must be an ancestor of %s", root, feature)
+	}
+
+	ok, err = IsAncestor(ctx, main, feature, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok {
+		t.Fatalf("%s must not be an ancestor of %s", main, feature)
+	}
+
+	_, err = IsAncestor(ctx, "unknown", feature, opt)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestAncestors_boundary(t *testing.T) {
+	output := "aaa bbb\n-bbb ccc\n"
+
+	var commits []Commit
+
+	for commit, err := range Ancestors(context.Background(), "main", gittest.StdoutExecutor(output), Boundary) {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		commits = append(commits, commit)
+	}
+
+	expected := []Commit{
+		{Hash: "aaa", Parents: []string{"bbb"}},
+		{Hash: "bbb", Parents: []string{"ccc"}, Boundary: true},
+	}
+
+	if !reflect.DeepEqual(commits, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", commits, expected)
+	}
+}
@@ -0,0 +1,31 @@
+package revlist
+
+import "github.com/kumose-go/xgit/types"
+
+// HyphenHyphen add `--`
+func HyphenHyphen(g *types.Cmd) {
+	g.AddOptions("--")
+}
+
+// Revisions [<revision-range>]
+// List only commits in the specified revision range (ex: `origin..HEAD`, `v1.0`, `^main`).
+func Revisions(revisions ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, revision := range revisions {
+			g.AddOptions(revision)
+		}
+	}
+}
+
+// PathSpecs [--] <path>...
+// List only commits that are enough to explain how the files that match the specified paths came to be.
+// Must be the last option.
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package revparse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// RepoInfo Information about the repository, as reported by `git rev-parse`.
+type RepoInfo struct {
+	// TopLevel The absolute path of the top-level directory of the working tree (empty outside a working tree).
+	TopLevel string
+	// GitDir The absolute path of the git directory.
+	GitDir string
+	// CommonDir The absolute path of the common git directory (differs from GitDir in a linked worktree).
+	CommonDir string
+	// SuperprojectWorkTree The absolute path of the superproject working tree, if the repository is a submodule.
+	SuperprojectWorkTree string
+	Bare                 bool
+	Shallow              bool
+	InsideWorkTree       bool
+	InsideGitDir         bool
+	// HEAD The commit of HEAD (empty on an unborn branch).
+	HEAD string
+	// Ref The full name of the current branch (ex: refs/heads/main), empty when HEAD is detached.
+	Ref string
+	// Branch The short name of the current branch (ex: main), empty when HEAD is detached.
+	Branch   string
+	Detached bool
+	// Unborn True when the current branch has no commit yet.
+	Unborn bool
+}
+
+// Info Returns the repository information with a single `git rev-parse` call.
+// Additional calls are only made for a bare repository or an unborn branch.
+func Info(ctx context.Context, options ...types.Option) (*RepoInfo, error) {
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(options...)
+	g.ApplyOptions(
+		PathFormat("absolute"),
+		AbsoluteGitDir,
+		GitCommonDir,
+		IsBareRepository,
+		IsInsideWorkTree,
+		IsInsideGitDir,
+		IsShallowRepository,
+		ShowSuperprojectWorkingTree,
+		ShowToplevel,
+		Args("HEAD"),
+		SymbolicFullName,
+		Args("HEAD"),
+	)
+
+	output, errCmd := g.Output(ctx)
+
+	lines := splitLines(output)
+
+	// --absolute-git-dir, --git-common-dir, --is-bare-repository, --is-inside-work-tree, --is-inside-git-dir, --is-shallow-repository
+	const fixed = 6
+
+	if len(lines) < fixed {
+		if errCmd != nil {
+			return nil, errCmd
+		}
+
+		return nil, fmt.Errorf("unexpected rev-parse output: %q", output)
+	}
+
+	info := &RepoInfo{
+		GitDir:         lines[0],
+		CommonDir:      lines[1],
+		Bare:           lines[2] == "true",
+		InsideWorkTree: lines[3] == "true",
+		InsideGitDir:   lines[4] == "true",
+		Shallow:        lines[5] == "true",
+	}
+
+	rest := lines[fixed:]
+
+	switch {
+	case errCmd == nil:
+		// [--show-superproject-working-tree] --show-toplevel HEAD --symbolic-full-name HEAD
+		if len(rest) == 4 {
+			info.SuperprojectWorkTree, rest = rest[0], rest[1:]
+		}
+
+		if len(rest) != 3 {
+			return nil, fmt.Errorf("unexpected rev-parse output: %q", output)
+		}
+
+		info.TopLevel = rest[0]
+		info.setHead(rest[1], rest[2])
+
+		return info, nil
+
+	case !info.InsideWorkTree:
+		// --show-toplevel fails outside a working tree.
+		if len(rest) > 0 {
+			info.SuperprojectWorkTree = rest[0]
+		}
+
+		err := info.resolveHead(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return info, nil
+
+	default:
+		// HEAD fails on an unborn branch, after being echoed as a non-revision argument.
+		if len(rest) > 0 && rest[len(rest)-1] == "HEAD" {
+			rest = rest[:len(rest)-1]
+		}
+
+		if len(rest) == 2 {
+			info.SuperprojectWorkTree, rest = rest[0], rest[1:]
+		}
+
+		if len(rest) != 1 {
+			return nil, errCmd
+		}
+
+		info.TopLevel = rest[0]
+
+		err := info.resolveUnborn(ctx, options)
+		if err != nil {
+			return nil, errCmd
+		}
+
+		return info, nil
+	}
+}
+
+func (r *RepoInfo) setHead(oid, ref string) {
+	r.HEAD = oid
+
+	if ref == "HEAD" {
+		r.Detached = true
+		return
+	}
+
+	r.Ref = ref
+	r.Branch = strings.TrimPrefix(ref, "refs/heads/")
+}
+
+// resolveHead resolves HEAD without --show-toplevel.
+func (r *RepoInfo) resolveHead(ctx context.Context, options []types.Option) error {
+	g := types.NewCmd("rev-parse")
+	g.ApplyOptions(types.GlobalOptions(options...))
+	g.ApplyOptions(Args("HEAD"), SymbolicFullName, Args("HEAD"))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		errUnborn := r.resolveUnborn(ctx, options)
+		if errUnborn != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	lines := splitLines(output)
+	if len(lines) != 2 {
+		return fmt.Errorf("unexpected rev-parse output: %q", output)
+	}
+
+	r.setHead(lines[0], lines[1])
+
+	return nil
+}
+
+// resolveUnborn reads the branch pointed by HEAD when HEAD cannot be resolved.
+func (r *RepoInfo) resolveUnborn(ctx context.Context, options []types.Option) error {
+	g := types.NewCmd("symbolic-ref")
+	g.ApplyOptions(types.GlobalOptions(options...))
+	g.AddOptions("--quiet")
+	g.AddOptions("HEAD")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.Unborn = true
+	r.setHead("", strings.TrimSpace(output))
+
+	return nil
+}
+
+func splitLines(output string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
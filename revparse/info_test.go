@@ -0,0 +1,83 @@
+package revparse
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestInfo(t *testing.T) {
+	dir := gittest.TempDir(t)
+	git := gittest.Open(t, dir).Git
+
+	repo := filepath.Join(dir, "repo")
+	bare := filepath.Join(dir, "bare.git")
+
+	git("init", "-q", repo)
+
+	// unborn branch
+	info, err := Info(context.Background(), global.UpperC(repo))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertInfo(t, info, RepoInfo{
+		TopLevel: repo, GitDir: filepath.Join(repo, ".git"), CommonDir: filepath.Join(repo, ".git"),
+		InsideWorkTree: true, Ref: "refs/heads/main", Branch: "main", Unborn: true,
+	})
+
+	git("-C", repo, "commit", "-q", "--allow-empty", "-m", "init")
+	head := git("-C", repo, "rev-parse", "HEAD")
+
+	info, err = Info(context.Background(), global.UpperC(repo))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertInfo(t, info, RepoInfo{
+		TopLevel: repo, GitDir: filepath.Join(repo, ".git"), CommonDir: filepath.Join(repo, ".git"),
+		InsideWorkTree: true, HEAD: head, Ref: "refs/heads/main", Branch: "main",
+	})
+
+	// detached HEAD
+	git("-C", repo, "checkout", "-q", "--detach")
+
+	info, err = Info(context.Background(), global.UpperC(repo))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertInfo(t, info, RepoInfo{
+		TopLevel: repo, GitDir: filepath.Join(repo, ".git"), CommonDir: filepath.Join(repo, ".git"),
+		InsideWorkTree: true, HEAD: head, Detached: true,
+	})
+
+	// bare repository
+	git("clone", "-q", "--bare", repo, bare)
+
+	info, err = Info(context.Background(), global.UpperC(bare))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertInfo(t, info, RepoInfo{
+		GitDir: bare, CommonDir: bare, Bare: true, InsideGitDir: true, HEAD: head, Ref: "refs/heads/main", Branch: "main",
+	})
+
+	// not a repository
+	_, err = Info(context.Background(), global.UpperC(dir))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func assertInfo(t *testing.T, got *RepoInfo, expected RepoInfo) {
+	t.Helper()
+
+	if *got != expected {
+		t.Fatalf("Got: %+v, expected: %+v.", *got, expected)
+	}
+}
@@ -151,6 +151,12 @@ func IsInsideWorkTree(g *types.Cmd) {
 	g.AddOptions("--is-inside-work-tree")
 }
 
+// IsShallowRepository When the repository is shallow print "true", otherwise "false".
+// --is-shallow-repository
+func IsShallowRepository(g *types.Cmd) {
+	g.AddOptions("--is-shallow-repository")
+}
+
 // KeepDashdash Only meaningful in --parseopt mode.
 // Tells the option parser to echo out the first -- met instead of skipping it.
 // --keep-dashdash
@@ -189,6 +195,17 @@ func Parseopt(g *types.Cmd) {
 	g.AddOptions("--parseopt")
 }
 
+// PathFormat Controls the behavior of certain other options.
+// If specified as absolute, the paths printed by those options will be absolute and canonical.
+// If specified as relative, the paths will be relative to the current working directory if that is possible.
+// The default is option specific.
+// --path-format=(absolute|relative)
+func PathFormat(value string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(fmt.Sprintf("--path-format=%s", value))
+	}
+}
+
 // Prefix Behave as if git rev-parse was invoked from the <arg> subdirectory of the working tree.
 // Any relative filenames are resolved as if they are prefixed by <arg> and will be printed in that form.
 // This can be used to convert arguments to a command run in a subdirectory so that they can still be used after moving to the top-level of the repository.
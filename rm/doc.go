@@ -0,0 +1,16 @@
+/*
+Package rm git-rm - Remove files from the working tree and from the index.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-rm
+
+	git rm [-f | --force] [-n] [-r] [--cached] [--ignore-unmatch]
+		  [--quiet] [--pathspec-from-file=<file> [--pathspec-file-nul]]
+		  [--] [<pathspec>…​]
+
+# DESCRIPTION
+
+Remove files matching pathspec from the index, or from the working tree and the index. git rm will not remove a file from just your working directory. (There is no option to remove a file only from the working tree and yet keep it in the index; use /bin/rm if you want to do that.) The files being removed have to be identical to the tip of the branch, and no updates to their contents can be staged in the index, though that default behavior can be overridden with the -f option. When --cached is given, the staged content has to match either the tip of the branch or the file on disk, allowing the file to be removed from just the index. When sparse-checkouts are in use (see git-sparse-checkout(1)), git rm will only remove paths within the sparse-checkout patterns.
+*/
+package rm
@@ -0,0 +1,40 @@
+package rm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Parse Returns the removed paths from the output of `git rm` (`rm '<path>'`),
+// or the paths that would be removed with DryRun.
+func Parse(output string) []string {
+	var paths []string
+
+	for _, line := range strings.Split(output, "\n") {
+		path, found := strings.CutPrefix(line, "rm '")
+		if !found || !strings.HasSuffix(path, "'") {
+			continue
+		}
+
+		paths = append(paths, strings.TrimSuffix(path, "'"))
+	}
+
+	return paths
+}
+
+// Preview Returns the paths that would be removed, using `git rm --dry-run`.
+// The options select the paths (ex: PathSpecs, Recursive, Cached).
+func Preview(ctx context.Context, options ...types.Option) ([]string, error) {
+	g := types.NewCmd("rm")
+	g.ApplyOptions(DryRun)
+	g.ApplyOptions(options...)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(output), nil
+}
@@ -0,0 +1,46 @@
+package rm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestPreview(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	for _, name := range []string{"a.txt", "docs/b.txt", "docs/c d.txt"} {
+		repo.WriteFile(name, name)
+	}
+
+	repo.Git("add", ".")
+
+	ctx := context.Background()
+
+	paths, err := Preview(ctx, global.UpperC(repo.Dir), Cached, Recursive, PathSpecs("docs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"docs/b.txt", "docs/c d.txt"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("Got: %q, expected: %q.", paths, expected)
+	}
+
+	paths, err = Preview(ctx, global.UpperC(repo.Dir), IgnoreUnmatch, PathSpecs("unknown.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paths) != 0 {
+		t.Fatalf("Got: %q, expected no path.", paths)
+	}
+
+	_, err = Preview(ctx, global.UpperC(repo.Dir), PathSpecs("unknown.txt"))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
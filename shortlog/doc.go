@@ -0,0 +1,23 @@
+/*
+Package shortlog git-shortlog - Summarize 'git log' output.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-shortlog
+
+	git shortlog [<options>] [<revision-range>] [[--] <path>...]
+	git log --pretty=short | git shortlog [<options>]
+
+# DESCRIPTION
+
+Summarizes git log output in a format suitable for inclusion in release announcements.
+Each commit will be grouped by author and title.
+
+Additionally, "[PATCH]" will be stripped from the commit description.
+
+If no revisions are passed on the command line and either standard input is not a terminal or there is no current branch,
+git shortlog will output a summary of the log read from standard input, without reference to the current repository.
+
+Entries returns the commit count of each author (or group), with the identities mapped by the mailmap.
+*/
+package shortlog
@@ -0,0 +1,193 @@
+package shortlog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// Identity A name and an email address (empty without the Email option).
+type Identity struct {
+	Name  string
+	Email string
+}
+
+// String Returns `Name <Email>`, or the name only if there is no email address.
+func (i Identity) String() string {
+	if i.Email == "" {
+		return i.Name
+	}
+
+	if i.Name == "" {
+		return "<" + i.Email + ">"
+	}
+
+	return fmt.Sprintf("%s <%s>", i.Name, i.Email)
+}
+
+// Entry The number of commits of an author (or of a value of the Group).
+type Entry struct {
+	Identity
+	Count int
+}
+
+// Entries Returns the number of commits per author of the revisions (HEAD if none), using `git shortlog --summary`.
+// The identities are mapped by the mailmap (`git check-mailmap`), and the entries of the same identity are merged.
+// The entries are sorted by name, or by number of commits with the Numbered option.
+// The Format option is not supported.
+func Entries(ctx context.Context, revisions []string, options ...types.Option) ([]Entry, error) {
+	if len(revisions) == 0 {
+		// without revision, `git shortlog` reads the log from the standard input.
+		revisions = []string{"HEAD"}
+	}
+
+	g := types.NewCmd("shortlog")
+	g.ApplyOptions(Summary)
+	g.ApplyOptions(options...)
+	g.ApplyOptions(Revisions(revisions...))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := Parse(output)
+	if err != nil {
+		return nil, err
+	}
+
+	identities := make([]Identity, len(entries))
+	for i, entry := range entries {
+		identities[i] = entry.Identity
+	}
+
+	mapped, err := MapIdentities(ctx, identities, types.GlobalOptions(options...))
+	if err != nil {
+		return nil, err
+	}
+
+	var merged []Entry
+
+	for i, entry := range entries {
+		entry.Identity = mapped[i]
+
+		index := slices.IndexFunc(merged, func(e Entry) bool { return e.Identity == entry.Identity })
+		if index < 0 {
+			merged = append(merged, entry)
+			continue
+		}
+
+		merged[index].Count += entry.Count
+	}
+
+	if len(merged) != len(entries) {
+		sortEntries(merged, slices.Contains(g.Options, "--numbered"))
+	}
+
+	return merged, nil
+}
+
+// Parse Parses the output of `git shortlog --summary`: `<count> TAB <name> [<email>]`.
+func Parse(output string) ([]Entry, error) {
+	var entries []Entry
+
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		count, value, found := strings.Cut(line, "\t")
+		if !found {
+			return nil, fmt.Errorf("invalid shortlog entry: %q", line)
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return nil, fmt.Errorf("invalid shortlog entry: %q", line)
+		}
+
+		entries = append(entries, Entry{Identity: ParseIdentity(value), Count: n})
+	}
+
+	return entries, nil
+}
+
+// ParseIdentity Parses `Name <email>`: a value without email address is only a name.
+func ParseIdentity(value string) Identity {
+	if !strings.HasSuffix(value, ">") {
+		return Identity{Name: value}
+	}
+
+	i := strings.LastIndex(value, "<")
+	if i < 0 {
+		return Identity{Name: value}
+	}
+
+	return Identity{Name: strings.TrimSpace(value[:i]), Email: value[i+1 : len(value)-1]}
+}
+
+// MapIdentities Returns the canonical identities (defined by the mailmap) of the identities, in the same order, using `git check-mailmap --stdin`.
+// The identities without email address are returned unchanged.
+func MapIdentities(ctx context.Context, identities []Identity, options ...types.Option) ([]Identity, error) {
+	mapped := slices.Clone(identities)
+
+	var (
+		contacts []string
+		indexes  []int
+	)
+
+	for i, identity := range identities {
+		if identity.Email == "" {
+			continue
+		}
+
+		contacts = append(contacts, identity.String())
+		indexes = append(indexes, i)
+	}
+
+	if len(contacts) == 0 {
+		return mapped, nil
+	}
+
+	g := types.NewCmd("check-mailmap")
+	g.ApplyOptions(options...)
+	g.AddOptions("--stdin")
+	g.Stdin = strings.NewReader(strings.Join(contacts, "\n") + "\n")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) != len(contacts) {
+		return nil, fmt.Errorf("unexpected check-mailmap output: %q", output)
+	}
+
+	for i, line := range lines {
+		identity := ParseIdentity(line)
+
+		// a contact without name (`<email>`) stays without name.
+		if identity.Name == "" {
+			identity.Name = identities[indexes[i]].Name
+		}
+
+		mapped[indexes[i]] = identity
+	}
+
+	return mapped, nil
+}
+
+func sortEntries(entries []Entry, numbered bool) {
+	slices.SortStableFunc(entries, func(a, b Entry) int {
+		if numbered && a.Count != b.Count {
+			return b.Count - a.Count
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+}
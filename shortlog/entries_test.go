@@ -0,0 +1,78 @@
+package shortlog
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestEntries(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.GitAs("alice", "alice@old.example.com", "commit", "-q", "--allow-empty", "-m", "one")
+	repo.GitAs("Alice", "alice@example.com", "commit", "-q", "--allow-empty", "-m", "two")
+	repo.GitAs("Bob", "bob@example.com", "commit", "-q", "--allow-empty", "-m", "three\n\nCo-authored-by: alice <alice@old.example.com>")
+	repo.GitAs("Alice", "alice@example.com", "commit", "-q", "--allow-empty", "-m", "four")
+
+	repo.WriteFile(".mailmap", "Alice <alice@example.com> <alice@old.example.com>\n")
+
+	ctx := context.Background()
+
+	entries, err := Entries(ctx, nil, global.UpperC(repo.Dir), Email, Numbered)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry{
+		{Identity: Identity{Name: "Alice", Email: "alice@example.com"}, Count: 3},
+		{Identity: Identity{Name: "Bob", Email: "bob@example.com"}, Count: 1},
+	}
+
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	entries, err = Entries(ctx, []string{"main~2..main"}, global.UpperC(repo.Dir), Email, Group(GroupTrailer("co-authored-by")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []Entry{{Identity: Identity{Name: "Alice", Email: "alice@example.com"}, Count: 1}}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	mapped, err := MapIdentities(ctx, []Identity{{Name: "someone"}, {Name: "alice", Email: "alice@old.example.com"}}, global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedIdentities := []Identity{{Name: "someone"}, {Name: "Alice", Email: "alice@example.com"}}
+	if !reflect.DeepEqual(mapped, expectedIdentities) {
+		t.Fatalf("Got: %+v, expected: %+v.", mapped, expectedIdentities)
+	}
+}
+
+func TestParse(t *testing.T) {
+	entries, err := Parse("    12\tJohn Doe <john@example.com>\n     3\tJane\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry{
+		{Identity: Identity{Name: "John Doe", Email: "john@example.com"}, Count: 12},
+		{Identity: Identity{Name: "Jane"}, Count: 3},
+	}
+
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	_, err = Parse("John Doe\n")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
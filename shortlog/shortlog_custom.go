@@ -0,0 +1,44 @@
+package shortlog
+
+import (
+	"github.com/kumose-go/xgit/types"
+)
+
+// Groups of commits (see Group).
+const (
+	GroupAuthor    = "author"
+	GroupCommitter = "committer"
+)
+
+// GroupTrailer The group of the values of a trailer (ex: `co-authored-by`), for Group.
+func GroupTrailer(field string) string {
+	return "trailer:" + field
+}
+
+// Revisions Show only commits in the specified revision range.
+// When no <revision-range> is specified, it defaults to HEAD (i.e. the whole history leading to the current commit).
+// [<revision-range>]
+func Revisions(revisions ...string) types.Option {
+	return func(g *types.Cmd) {
+		for _, revision := range revisions {
+			g.AddOptions(revision)
+		}
+	}
+}
+
+// PathSpecs Consider only commits that are enough to explain how the files that match the specified paths came to be.
+// Must be used after Revisions.
+// [[--] <path>...]
+func PathSpecs(pathSpecs ...string) types.Option {
+	return func(g *types.Cmd) {
+		if len(pathSpecs) == 0 {
+			return
+		}
+
+		g.AddOptions("--")
+
+		for _, pathSpec := range pathSpecs {
+			g.AddOptions(pathSpec)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package sparsecheckout
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/kumose-go/xgit/clone"
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Clone Clones a repository into a sparse-checkout of the given directories (cone mode),
+// using `git clone --filter=blob:none --sparse` followed by `git sparse-checkout set --cone`:
+// only the blobs of the checked out files are downloaded.
+// The options are the clone options (the filter can be replaced with clone.Filter,
+// the sparse index can be enabled with `clone.Config("index.sparse", "true")`) and the global options.
+func Clone(ctx context.Context, repository, directory string, patterns []Pattern, options ...types.Option) error {
+	if directory == "" {
+		return errors.New("the directory of the clone is required")
+	}
+
+	g := types.NewCmd("clone")
+	g.ApplyOptions(options...)
+
+	if !slices.ContainsFunc(g.Options, func(option string) bool { return strings.HasPrefix(option, "--filter=") }) {
+		g.ApplyOptions(clone.Filter("blob:none"))
+	}
+
+	g.ApplyOptions(clone.Sparse, clone.Repository(repository), clone.Directory(directory))
+
+	_, err := g.Output(ctx)
+	if err != nil {
+		return err
+	}
+
+	g = types.NewCmd("sparse-checkout")
+	g.ApplyOptions(types.GlobalOptions(options...), global.UpperC(directory))
+	g.ApplyOptions(Set(patterns, Cone))
+
+	_, err = g.Output(ctx)
+
+	return err
+}
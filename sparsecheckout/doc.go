@@ -0,0 +1,17 @@
+/*
+Package sparsecheckout git-sparse-checkout - Reduce your working tree to a subset of tracked files.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-sparse-checkout
+
+	git sparse-checkout (init | list | set | add | reapply | disable | check-rules) [<options>]
+
+# DESCRIPTION
+
+This command is used to create sparse checkouts, which change the working tree from having all tracked files present to only having a subset of those files.
+It can also switch which subset of files are present, or undo and go back to having all tracked files present in the working copy.
+
+The subset of files is chosen by providing a list of directories in cone mode (the default), or by providing a list of patterns in non-cone mode.
+*/
+package sparsecheckout
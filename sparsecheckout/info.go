@@ -0,0 +1,42 @@
+package sparsecheckout
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kumose-go/xgit/config"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Info The sparse-checkout settings of a worktree.
+type Info struct {
+	// Enabled The sparse-checkout is enabled (`core.sparseCheckout`).
+	Enabled bool
+	// Cone The patterns use the cone mode (`core.sparseCheckoutCone`).
+	Cone bool
+	// SparseIndex The sparse index is enabled (`index.sparse`).
+	SparseIndex bool
+}
+
+// ReadInfo Returns the sparse-checkout settings, read from the configuration (including the worktree configuration).
+func ReadInfo(ctx context.Context, options ...types.Option) (Info, error) {
+	snapshot, err := config.Load(ctx, types.GlobalOptions(options...))
+	if err != nil {
+		return Info{}, err
+	}
+
+	var info Info
+
+	for key, value := range map[string]*bool{
+		"core.sparseCheckout":     &info.Enabled,
+		"core.sparseCheckoutCone": &info.Cone,
+		"index.sparse":            &info.SparseIndex,
+	} {
+		*value, err = snapshot.Bool(key)
+		if err != nil && !errors.Is(err, config.ErrNotFound) {
+			return Info{}, err
+		}
+	}
+
+	return info, nil
+}
@@ -0,0 +1,73 @@
+package sparsecheckout
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// ListPatterns Returns the patterns of the sparse-checkout, using `git sparse-checkout list`.
+// Fails if the sparse-checkout is not enabled.
+func ListPatterns(ctx context.Context, options ...types.Option) ([]Pattern, error) {
+	g := types.NewCmd("sparse-checkout")
+	g.ApplyOptions(List(options...))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePatterns(output), nil
+}
+
+// ParsePatterns Parses the output of `git sparse-checkout list`.
+// The C-quoted directories of the cone mode are unquoted.
+func ParsePatterns(output string) []Pattern {
+	var patterns []Pattern
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, `"`) {
+			unquoted, err := strconv.Unquote(line)
+			if err == nil {
+				line = unquoted
+			}
+		}
+
+		patterns = append(patterns, Pattern(line))
+	}
+
+	return patterns
+}
+
+// MatchingPaths Returns the paths matching the patterns, using `git sparse-checkout check-rules -z` (requires git 2.41).
+// The options are the check-rules options (Cone, NoCone, RulesFile) and the global options.
+func MatchingPaths(ctx context.Context, paths []string, options ...types.Option) ([]string, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	g := types.NewCmd("sparse-checkout")
+	g.ApplyOptions(CheckRules(append(options, Z)...))
+	g.Stdin = strings.NewReader(strings.Join(paths, "\x00") + "\x00")
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matching []string
+
+	for _, path := range strings.Split(output, "\x00") {
+		if path != "" {
+			matching = append(matching, path)
+		}
+	}
+
+	return matching, nil
+}
@@ -0,0 +1,145 @@
+package sparsecheckout
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/types"
+)
+
+func TestParsePatterns(t *testing.T) {
+	patterns := ParsePatterns("src/app\n\"docs/\\303\\251t\\303\\251\"\n")
+
+	expected := []Pattern{"src/app", "docs/été"}
+	if !reflect.DeepEqual(patterns, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", patterns, expected)
+	}
+}
+
+func TestMatchingPaths(t *testing.T) {
+	var (
+		args  []string
+		stdin string
+	)
+
+	executor := func(g *types.Cmd) {
+		g.Executor = func(_ context.Context, _ string, _ bool, a ...string) (string, error) {
+			args = a
+
+			in, err := io.ReadAll(g.Stdin)
+			if err != nil {
+				return "", err
+			}
+
+			stdin = string(in)
+
+			_, err = io.WriteString(g.Stdout, "src/app/main.go\x00")
+
+			return "", err
+		}
+	}
+
+	paths, err := MatchingPaths(context.Background(), []string{"src/app/main.go", "docs/README.md"}, RulesFile("rules"), executor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(paths, []string{"src/app/main.go"}) {
+		t.Fatalf("Got: %+v, expected: [src/app/main.go].", paths)
+	}
+
+	expectedArgs := []string{"sparse-checkout", "check-rules", "--rules-file", "rules", "-z"}
+	if !reflect.DeepEqual(args, expectedArgs) {
+		t.Fatalf("Got: %+v, expected: %+v.", args, expectedArgs)
+	}
+
+	if stdin != "src/app/main.go\x00docs/README.md\x00" {
+		t.Fatalf("Got: %q, expected: the NUL-terminated paths.", stdin)
+	}
+}
+
+func TestClone(t *testing.T) {
+	origin := gittest.NewRepo(t)
+
+	for _, name := range []string{"README.md", "src/app/main.go", "src/lib/lib.go", "docs/index.md"} {
+		origin.WriteFile(name, name)
+	}
+
+	origin.Git("config", "uploadpack.allowFilter", "true")
+	origin.Git("add", ".")
+	origin.Git("commit", "-q", "-m", "init")
+
+	dir := gittest.TempDir(t)
+
+	err := Clone(context.Background(), "file://"+filepath.ToSlash(origin.Dir), "clone", []Pattern{"src/app"}, global.UpperC(dir), global.LowerC("init.defaultBranch", "main"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cloneDir := filepath.Join(dir, "clone")
+
+	for name, expected := range map[string]bool{"README.md": true, "src/app/main.go": true, "src/lib/lib.go": false, "docs/index.md": false} {
+		_, errStat := os.Stat(filepath.Join(cloneDir, name))
+		if (errStat == nil) != expected {
+			t.Errorf("%s: Got: %v, expected: %v.", name, errStat == nil, expected)
+		}
+	}
+
+	patterns, err := ListPatterns(context.Background(), global.UpperC(cloneDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(patterns, []Pattern{"src/app"}) {
+		t.Fatalf("Got: %+v, expected: [src/app].", patterns)
+	}
+
+	info, err := ReadInfo(context.Background(), global.UpperC(cloneDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info != (Info{Enabled: true, Cone: true}) {
+		t.Fatalf("Got: %+v, expected: enabled in cone mode.", info)
+	}
+
+	g := types.NewCmd("sparse-checkout")
+	g.ApplyOptions(global.UpperC(cloneDir), Add([]Pattern{"docs"}))
+
+	_, err = g.Output(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patterns, err = ListPatterns(context.Background(), global.UpperC(cloneDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(patterns, []Pattern{"docs", "src/app"}) {
+		t.Fatalf("Got: %+v, expected: [docs src/app].", patterns)
+	}
+
+	g = types.NewCmd("sparse-checkout")
+	g.ApplyOptions(global.UpperC(cloneDir), Disable())
+
+	_, err = g.Output(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err = ReadInfo(context.Background(), global.UpperC(cloneDir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if info.Enabled {
+		t.Fatalf("Got: %+v, expected: disabled.", info)
+	}
+}
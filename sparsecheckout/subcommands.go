@@ -0,0 +1,62 @@
+package sparsecheckout
+
+import "github.com/kumose-go/xgit/types"
+
+// Pattern A sparse-checkout pattern: a directory in cone mode (ex: `src/app`),
+// a gitignore-style pattern in non-cone mode (ex: `/*`, `!/*/`).
+type Pattern string
+
+// Init Enable the sparse-checkout, with only the files of the toplevel directory (deprecated in favor of Set).
+// usage: git sparse-checkout init [--cone] [--[no-]sparse-index]
+func Init(options ...types.Option) types.Option {
+	return subCommand("init", nil, options)
+}
+
+// Set Enable the sparse-checkout if needed, and replace the patterns by the given ones.
+// The patterns must not start with a dash (use Stdin instead).
+// usage: git sparse-checkout set [--[no-]cone] [--[no-]sparse-index] [--skip-checks] (--stdin | <patterns>...)
+func Set(patterns []Pattern, options ...types.Option) types.Option {
+	return subCommand("set", patterns, options)
+}
+
+// Add patterns to the sparse-checkout.
+// The patterns must not start with a dash (use Stdin instead).
+// usage: git sparse-checkout add [--skip-checks] (--stdin | <patterns>...)
+func Add(patterns []Pattern, options ...types.Option) types.Option {
+	return subCommand("add", patterns, options)
+}
+
+// Reapply the patterns to the working tree (ex: after resolving conflicts outside the sparse-checkout).
+// usage: git sparse-checkout reapply [--[no-]cone] [--[no-]sparse-index]
+func Reapply(options ...types.Option) types.Option {
+	return subCommand("reapply", nil, options)
+}
+
+// List Show the patterns of the sparse-checkout (the directories in cone mode).
+// usage: git sparse-checkout list
+func List(options ...types.Option) types.Option {
+	return subCommand("list", nil, options)
+}
+
+// Disable the sparse-checkout: all the tracked files are restored in the working tree.
+// usage: git sparse-checkout disable
+func Disable(options ...types.Option) types.Option {
+	return subCommand("disable", nil, options)
+}
+
+// CheckRules Show which of the paths read from the standard input match the patterns (requires git 2.41).
+// usage: git sparse-checkout check-rules [-z] [--skip-checks] [--[no-]cone] [--rules-file <file>]
+func CheckRules(options ...types.Option) types.Option {
+	return subCommand("check-rules", nil, options)
+}
+
+func subCommand(name string, patterns []Pattern, options []types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(name)
+		g.ApplyOptions(options...)
+
+		for _, pattern := range patterns {
+			g.AddOptions(string(pattern))
+		}
+	}
+}
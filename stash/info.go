@@ -0,0 +1,201 @@
+package stash
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kumose-go/xgit/lsfiles"
+	"github.com/kumose-go/xgit/types"
+)
+
+const listFormat = "--format=%gd%x00%H%x00%ct%x00%gs"
+
+// Entry A stash entry.
+type Entry struct {
+	Index   int
+	Ref     string
+	Branch  string
+	Message string
+	Date    time.Time
+	OID     string
+}
+
+// Result The result of a stash pop or apply.
+type Result struct {
+	// Ref The applied stash entry.
+	Ref string
+	// OID The commit of the applied stash entry, useful to recover a dropped entry.
+	OID string
+	// Files The files changed by the stash entry.
+	Files []string
+	// Conflicted True if the stash entry was applied with conflicts.
+	Conflicted bool
+	// Conflicts The conflicted paths.
+	Conflicts []string
+	// Dropped True if the stash entry was removed from the stash list (pop without conflict).
+	Dropped bool
+}
+
+// ListInfo Returns the stash entries, using `git stash list`.
+func ListInfo(ctx context.Context, options ...types.Option) ([]Entry, error) {
+	g := types.NewCmd("stash")
+	g.AddOptions("list")
+	g.AddOptions(listFormat)
+	g.ApplyOptions(options...)
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseList(output)
+}
+
+// PopInfo Pops a stash entry (the latest if stash is empty), using `git stash pop`.
+// A conflict is not an error: it's reported by the Result and the stash entry is kept.
+func PopInfo(ctx context.Context, stash string, options ...types.Option) (*Result, error) {
+	return apply(ctx, "pop", stash, options...)
+}
+
+// ApplyInfo Applies a stash entry (the latest if stash is empty), using `git stash apply`.
+// A conflict is not an error: it's reported by the Result.
+func ApplyInfo(ctx context.Context, stash string, options ...types.Option) (*Result, error) {
+	return apply(ctx, "apply", stash, options...)
+}
+
+func apply(ctx context.Context, subCommand, stash string, options ...types.Option) (*Result, error) {
+	if stash == "" {
+		stash = "stash@{0}"
+	}
+
+	result := &Result{Ref: stash}
+
+	rp := types.NewCmd("rev-parse")
+	rp.ApplyOptions(types.GlobalOptions(options...))
+	rp.AddOptions("--verify")
+	rp.AddOptions(stash + "^{commit}")
+
+	oid, err := rp.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result.OID = strings.TrimSpace(oid)
+
+	show := types.NewCmd("stash")
+	show.ApplyOptions(types.GlobalOptions(options...))
+	show.AddOptions("show")
+	show.AddOptions("--name-only")
+	show.AddOptions("--include-untracked")
+	show.AddOptions("-z")
+	show.AddOptions(result.OID)
+
+	files, err := show.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Files = splitNul(files)
+
+	// the unmerged entries of the index before the apply are not conflicts of the stash.
+	unmerged, err := lsfiles.ListConflicts(ctx, types.GlobalOptions(options...))
+	if err != nil {
+		return nil, err
+	}
+
+	g := types.NewCmd("stash")
+	g.AddOptions(subCommand)
+	g.ApplyOptions(options...)
+	g.AddOptions(stash)
+
+	_, err = g.Output(ctx)
+	if err == nil {
+		result.Dropped = subCommand == "pop"
+		return result, nil
+	}
+
+	if len(unmerged) > 0 {
+		return nil, err
+	}
+
+	conflicts, errLs := lsfiles.ListConflicts(ctx, types.GlobalOptions(options...))
+	if errLs != nil || len(conflicts) == 0 {
+		return nil, err
+	}
+
+	result.Conflicted = true
+
+	for _, conflict := range conflicts {
+		result.Conflicts = append(result.Conflicts, conflict.Path)
+	}
+
+	return result, nil
+}
+
+func parseList(output string) ([]Entry, error) {
+	var entries []Entry
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		fields := strings.SplitN(line, "\x00", 4)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid stash entry: %q", line)
+		}
+
+		entry := Entry{Ref: fields[0], OID: fields[1]}
+
+		index, found := strings.CutPrefix(fields[0], "stash@{")
+		if found {
+			entry.Index, _ = strconv.Atoi(strings.TrimSuffix(index, "}"))
+		}
+
+		timestamp, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stash date: %q", line)
+		}
+
+		entry.Date = time.Unix(timestamp, 0)
+		entry.Branch, entry.Message = parseSubject(fields[3])
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parseSubject parses `WIP on <branch>: <message>` or `On <branch>: <message>`.
+func parseSubject(subject string) (branch, message string) {
+	rest, found := strings.CutPrefix(subject, "WIP on ")
+	if !found {
+		rest, found = strings.CutPrefix(subject, "On ")
+	}
+
+	if !found {
+		return "", subject
+	}
+
+	branch, message, found = strings.Cut(rest, ": ")
+	if !found {
+		return "", subject
+	}
+
+	return branch, message
+}
+
+func splitNul(output string) []string {
+	var values []string
+
+	for _, value := range strings.Split(output, "\x00") {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
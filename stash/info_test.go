@@ -0,0 +1,79 @@
+package stash
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func Test_parseList(t *testing.T) {
+	output := "stash@{0}\x0046001b6aa3a1d9a1b5c0c4be0a52a4e4b7a2a0e1\x001792423510\x00WIP on master: 46001b6 c2\n" +
+		"stash@{1}\x0087e59d54150a56467e3f9da45358ed4774d2726f\x001792423500\x00On feat/x: my msg: with colon\n" +
+		"stash@{2}\x0011e59d54150a56467e3f9da45358ed4774d27211\x001792423400\x00On (no branch): detached\n"
+
+	entries, err := parseList(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry{
+		{Index: 0, Ref: "stash@{0}", Branch: "master", Message: "46001b6 c2", Date: time.Unix(1792423510, 0), OID: "46001b6aa3a1d9a1b5c0c4be0a52a4e4b7a2a0e1"},
+		{Index: 1, Ref: "stash@{1}", Branch: "feat/x", Message: "my msg: with colon", Date: time.Unix(1792423500, 0), OID: "87e59d54150a56467e3f9da45358ed4774d2726f"},
+		{Index: 2, Ref: "stash@{2}", Branch: "(no branch)", Message: "detached", Date: time.Unix(1792423400, 0), OID: "11e59d54150a56467e3f9da45358ed4774d27211"},
+	}
+
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+}
+
+func TestPopInfo(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	repo.WriteFile("a.txt", "a\n")
+	repo.Git("add", ".")
+	repo.Git("commit", "-q", "-m", "init")
+
+	repo.WriteFile("a.txt", "stashed\n")
+	repo.WriteFile("b.txt", "b\n")
+	repo.Git("stash", "push", "-q", "--include-untracked", "-m", "work")
+
+	repo.WriteFile("a.txt", "committed\n")
+	repo.Git("commit", "-q", "-am", "change")
+
+	entries, err := ListInfo(context.Background(), global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].Message != "work" {
+		t.Fatalf("unexpected stash list: %+v", entries)
+	}
+
+	result, err := PopInfo(context.Background(), "", global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &Result{
+		Ref:        "stash@{0}",
+		OID:        entries[0].OID,
+		Files:      []string{"a.txt", "b.txt"},
+		Conflicted: true,
+		Conflicts:  []string{"a.txt"},
+	}
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", result, expected)
+	}
+
+	// the index is already unmerged: the failure is not a conflict of the stash.
+	_, err = ApplyInfo(context.Background(), "", global.UpperC(repo.Dir))
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
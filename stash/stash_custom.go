@@ -1,15 +1,27 @@
 package stash
 
-import "github.com/kumose-go/xgit/types"
+import (
+	"fmt"
+
+	"github.com/kumose-go/xgit/types"
+)
 
 // Push git stash push [-p|--patch] [-S|--staged] [-k|--[no-]keep-index] [-u|--include-untracked] [-a|--all] [-q|--quiet] [(-m|--message) <message>] [--pathspec-from-file=<file> [--pathspec-file-nul]] [--] [<pathspec>...]
-func Push(message string, options ...types.Option) types.Option {
+func Push(message string, pathSpecs []string, options ...types.Option) types.Option {
 	return func(g *types.Cmd) {
 		g.AddOptions("push")
 		g.ApplyOptions(options...)
 
 		if message != "" {
-			g.AddOptions(message)
+			g.AddOptions(fmt.Sprintf("--message=%s", message))
+		}
+
+		if len(pathSpecs) > 0 {
+			g.AddOptions("--")
+
+			for _, pathSpec := range pathSpecs {
+				g.AddOptions(pathSpec)
+			}
 		}
 	}
 }
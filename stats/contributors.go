@@ -0,0 +1,97 @@
+package stats
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/kumose-go/xgit/log"
+	"github.com/kumose-go/xgit/shortlog"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Contributor The contributions of an author.
+type Contributor struct {
+	shortlog.Identity
+	// Commits The number of commits.
+	Commits int
+	// Added The number of added lines (binary files excluded).
+	Added int
+	// Deleted The number of deleted lines (binary files excluded).
+	Deleted int
+}
+
+// Contributors Returns the contributions of each author of a revision range (ex: `v1.0..v2.0`, HEAD if empty),
+// sorted by number of commits.
+// The commits are counted by `git shortlog`, the lines by `git log --numstat` (the merge commits have no lines),
+// and the identities are mapped by the mailmap.
+// The options are the global options.
+func Contributors(ctx context.Context, revisionRange string, options ...types.Option) ([]Contributor, error) {
+	if revisionRange == "" {
+		revisionRange = "HEAD"
+	}
+
+	globalOptions := types.GlobalOptions(options...)
+
+	entries, err := shortlog.Entries(ctx, []string{revisionRange}, globalOptions, shortlog.Email, shortlog.Numbered)
+	if err != nil {
+		return nil, err
+	}
+
+	contributors := make([]Contributor, len(entries))
+	for i, entry := range entries {
+		contributors[i] = Contributor{Identity: entry.Identity, Commits: entry.Count}
+	}
+
+	// the lines per raw author identity, mapped at the end.
+	var (
+		authors []shortlog.Identity
+		lines   [][2]int
+	)
+
+	for commit, errLog := range log.Commits(ctx, globalOptions, log.Numstat, log.Revisions(revisionRange)) {
+		if errLog != nil {
+			return nil, errLog
+		}
+
+		author := shortlog.Identity{Name: commit.Author.Name, Email: commit.Author.Email}
+
+		index := slices.Index(authors, author)
+		if index < 0 {
+			authors = append(authors, author)
+			lines = append(lines, [2]int{})
+			index = len(authors) - 1
+		}
+
+		for _, file := range commit.Files {
+			lines[index][0] += file.Added
+			lines[index][1] += file.Deleted
+		}
+	}
+
+	mapped, err := shortlog.MapIdentities(ctx, authors, globalOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, identity := range mapped {
+		index := slices.IndexFunc(contributors, func(c Contributor) bool { return c.Identity == identity })
+		if index < 0 {
+			contributors = append(contributors, Contributor{Identity: identity})
+			index = len(contributors) - 1
+		}
+
+		contributors[index].Added += lines[i][0]
+		contributors[index].Deleted += lines[i][1]
+	}
+
+	slices.SortStableFunc(contributors, func(a, b Contributor) int {
+		if a.Commits != b.Commits {
+			return b.Commits - a.Commits
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return contributors, nil
+}
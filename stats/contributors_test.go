@@ -0,0 +1,53 @@
+package stats
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+	"github.com/kumose-go/xgit/shortlog"
+)
+
+func TestContributors(t *testing.T) {
+	repo := gittest.NewRepo(t)
+
+	commit := func(name, email, file, content string) {
+		t.Helper()
+
+		repo.WriteFile(file, content)
+		repo.Git("add", file)
+		repo.GitAs(name, email, "commit", "-q", "-m", "update "+file)
+	}
+
+	commit("alice", "alice@old.example.com", "a.txt", "1\n2\n3\n")
+	commit("Bob", "bob@example.com", "b.txt", "1\n")
+	commit("Alice", "alice@example.com", "a.txt", "1\n")
+
+	repo.WriteFile(".mailmap", "Alice <alice@example.com> <alice@old.example.com>\n")
+
+	contributors, err := Contributors(context.Background(), "", global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Contributor{
+		{Identity: shortlog.Identity{Name: "Alice", Email: "alice@example.com"}, Commits: 2, Added: 3, Deleted: 2},
+		{Identity: shortlog.Identity{Name: "Bob", Email: "bob@example.com"}, Commits: 1, Added: 1},
+	}
+
+	if !reflect.DeepEqual(contributors, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", contributors, expected)
+	}
+
+	contributors, err = Contributors(context.Background(), "main~1..main", global.UpperC(repo.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected = []Contributor{{Identity: shortlog.Identity{Name: "Alice", Email: "alice@example.com"}, Commits: 1, Deleted: 2}}
+	if !reflect.DeepEqual(contributors, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", contributors, expected)
+	}
+}
@@ -0,0 +1,7 @@
+/*
+Package stats contains statistics about the history of a repository, built from several git commands.
+
+Contributors returns the commits, added and deleted lines of each author of a revision range,
+combining `git shortlog` and `git log --numstat`, with the identities mapped by the mailmap.
+*/
+package stats
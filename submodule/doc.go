@@ -0,0 +1,27 @@
+/*
+Package submodule git-submodule - Initialize, update or inspect submodules.
+
+# SYNOPSIS
+
+Reference: https://git-scm.com/docs/git-submodule
+
+	git submodule [--quiet] [--cached]
+	git submodule [--quiet] add [<options>] [--] <repository> [<path>]
+	git submodule [--quiet] status [--cached] [--recursive] [--] [<path>…​]
+	git submodule [--quiet] init [--] [<path>…​]
+	git submodule [--quiet] deinit [-f|--force] (--all|[--] <path>…​)
+	git submodule [--quiet] update [<options>] [--] [<path>…​]
+	git submodule [--quiet] set-branch [<options>] [--] <path>
+	git submodule [--quiet] set-url [--] <path> <newurl>
+	git submodule [--quiet] summary [<options>] [--] [<path>…​]
+	git submodule [--quiet] foreach [--recursive] <command>
+	git submodule [--quiet] sync [--recursive] [--] [<path>…​]
+	git submodule [--quiet] absorbgitdirs [--] [<path>…​]
+
+# DESCRIPTION
+
+Inspects, updates and manages submodules.
+
+For more information about submodules, see gitsubmodules(7).
+*/
+package submodule
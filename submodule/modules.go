@@ -0,0 +1,62 @@
+package submodule
+
+import (
+	"context"
+	"io"
+
+	"github.com/kumose-go/xgit/config"
+	"github.com/kumose-go/xgit/types"
+)
+
+// Module A submodule declared in a .gitmodules file.
+type Module struct {
+	// Name The name of the submodule (the subsection of `[submodule "<name>"]`).
+	Name   string
+	Path   string `gitconfig:"path"`
+	URL    string `gitconfig:"url"`
+	Branch string `gitconfig:"branch"`
+	// Update The update procedure: checkout, rebase, merge or none.
+	Update string `gitconfig:"update"`
+	// Ignore When the submodule is considered modified: all, dirty, untracked or none.
+	Ignore  string `gitconfig:"ignore"`
+	Shallow bool   `gitconfig:"shallow"`
+	// FetchRecurseSubmodules The default of the recursive fetch: true, false or on-demand.
+	FetchRecurseSubmodules string `gitconfig:"fetchRecurseSubmodules"`
+}
+
+// ReadModules Returns the submodules declared in the .gitmodules file of the working tree, using `git config --file .gitmodules`.
+// The file must exist.
+func ReadModules(ctx context.Context, options ...types.Option) ([]Module, error) {
+	return loadModules(ctx, config.File(".gitmodules"), options)
+}
+
+// ParseModules Returns the submodules declared in a .gitmodules content (ex: `git show HEAD:.gitmodules`),
+// using `git config --file -`.
+func ParseModules(ctx context.Context, r io.Reader, options ...types.Option) ([]Module, error) {
+	return loadModules(ctx, func(g *types.Cmd) {
+		config.File("-")(g)
+		g.Stdin = r
+	}, options)
+}
+
+func loadModules(ctx context.Context, file types.Option, options []types.Option) ([]Module, error) {
+	snapshot, err := config.Load(ctx, types.GlobalOptions(options...), file)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []Module
+
+	for _, name := range snapshot.Subsections("submodule") {
+		module := Module{Name: name}
+
+		err = config.Unmarshal(snapshot, "submodule."+name, &module)
+		if err != nil {
+			return nil, err
+		}
+
+		modules = append(modules, module)
+	}
+
+	return modules, nil
+}
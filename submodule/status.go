@@ -0,0 +1,97 @@
+package submodule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kumose-go/xgit/types"
+)
+
+// State The state of a submodule, as the prefix of the `git submodule status` lines.
+type State byte
+
+// States of a submodule.
+const (
+	// StateInSync The checked out commit matches the commit recorded in the index of the superproject.
+	StateInSync State = ' '
+	// StateUninitialized The submodule is not initialized.
+	StateUninitialized State = '-'
+	// StateOutOfSync The checked out commit does not match the commit recorded in the index of the superproject.
+	StateOutOfSync State = '+'
+	// StateConflicted The submodule has merge conflicts.
+	StateConflicted State = 'U'
+)
+
+func (s State) String() string {
+	switch s {
+	case StateInSync:
+		return "in sync"
+	case StateUninitialized:
+		return "uninitialized"
+	case StateOutOfSync:
+		return "out of sync"
+	case StateConflicted:
+		return "conflicted"
+	default:
+		return "unknown"
+	}
+}
+
+// Entry The status of a submodule.
+type Entry struct {
+	// Path The path of the submodule, relative to the current directory.
+	Path string
+	// SHA The checked out commit (the commit of the index with Cached, or of the superproject if uninitialized).
+	SHA   string
+	State State
+	// Ref The commit described by `git describe` (ex: `heads/main`, `v1.0-2-gabc1234`), empty if unknown.
+	Ref string
+}
+
+// StatusInfo Returns the status of the submodules, using `git submodule status`.
+// The options are the status options (Cached, Recursive) and the global options.
+func StatusInfo(ctx context.Context, paths []string, options ...types.Option) ([]Entry, error) {
+	g := types.NewCmd("submodule")
+	g.ApplyOptions(Status(paths, options...))
+
+	output, err := g.Output(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseStatus(output)
+}
+
+// ParseStatus Parses the output of `git submodule status`: `<state><sha1> <path>[ (<ref>)]`.
+// A path ending with ` (<word>)` is ambiguous for an initialized submodule without ref: it's parsed as a ref.
+func ParseStatus(output string) ([]Entry, error) {
+	var entries []Entry
+
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+
+		entry := Entry{State: State(line[0])}
+
+		sha, rest, found := strings.Cut(line[1:], " ")
+		if !found || sha == "" || rest == "" || entry.State.String() == "unknown" {
+			return nil, fmt.Errorf("invalid submodule status: %q", line)
+		}
+
+		entry.SHA = sha
+		entry.Path = rest
+
+		// the ref is the part after the last ` (` (a ref has no space), git does not describe the uninitialized submodules.
+		if entry.State != StateUninitialized && strings.HasSuffix(rest, ")") {
+			if i := strings.LastIndex(rest, " ("); i > 0 && !strings.Contains(rest[i+2:], " ") {
+				entry.Path, entry.Ref = rest[:i], rest[i+2:len(rest)-1]
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,97 @@
+package submodule
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kumose-go/xgit/global"
+	"github.com/kumose-go/xgit/internal/gittest"
+)
+
+func TestParseStatus(t *testing.T) {
+	output := ` 1111111111111111111111111111111111111111 lib/a (heads/main)
+-2222222222222222222222222222222222222222 lib/b
++3333333333333333333333333333333333333333 lib/with space (v1.0-2-g3333333)
+U0000000000000000000000000000000000000000 lib/c
+-4444444444444444444444444444444444444444 lib/d (copy)
+ 5555555555555555555555555555555555555555 lib/e (old) (heads/main)
+`
+
+	entries, err := ParseStatus(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry{
+		{Path: "lib/a", SHA: "1111111111111111111111111111111111111111", State: StateInSync, Ref: "heads/main"},
+		{Path: "lib/b", SHA: "2222222222222222222222222222222222222222", State: StateUninitialized},
+		{Path: "lib/with space", SHA: "3333333333333333333333333333333333333333", State: StateOutOfSync, Ref: "v1.0-2-g3333333"},
+		{Path: "lib/c", SHA: "0000000000000000000000000000000000000000", State: StateConflicted},
+		{Path: "lib/d (copy)", SHA: "4444444444444444444444444444444444444444", State: StateUninitialized},
+		{Path: "lib/e (old)", SHA: "5555555555555555555555555555555555555555", State: StateInSync, Ref: "heads/main"},
+	}
+
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	_, err = ParseStatus("*1111111111111111111111111111111111111111 lib/a\n")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestStatusInfo(t *testing.T) {
+	lib := gittest.NewRepo(t)
+	lib.Git("commit", "-q", "--allow-empty", "-m", "init")
+	libHead := lib.Git("rev-parse", "HEAD")
+
+	super := gittest.NewRepo(t)
+	super.Git("-c", "protocol.file.allow=always", "submodule", "--quiet", "add", "-b", "main", lib.Dir, "deps/lib")
+	super.Git("commit", "-q", "-m", "add lib")
+
+	entries, err := StatusInfo(context.Background(), nil, global.UpperC(super.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Entry{{Path: "deps/lib", SHA: libHead, State: StateInSync, Ref: "heads/main"}}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("Got: %+v, expected: %+v.", entries, expected)
+	}
+
+	modules, err := ReadModules(context.Background(), global.UpperC(super.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedModules := []Module{{Name: "deps/lib", Path: "deps/lib", URL: lib.Dir, Branch: "main"}}
+	if !reflect.DeepEqual(modules, expectedModules) {
+		t.Fatalf("Got: %+v, expected: %+v.", modules, expectedModules)
+	}
+
+	content := "[submodule \"x\"]\n\tpath = vendor/x\n\turl = https://example.com/x.git\n\tshallow = true\n\tupdate = rebase\n"
+
+	modules, err = ParseModules(context.Background(), strings.NewReader(content), global.UpperC(super.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedModules = []Module{{Name: "x", Path: "vendor/x", URL: "https://example.com/x.git", Update: "rebase", Shallow: true}}
+	if !reflect.DeepEqual(modules, expectedModules) {
+		t.Fatalf("Got: %+v, expected: %+v.", modules, expectedModules)
+	}
+
+	super.Git("submodule", "--quiet", "deinit", "--all")
+
+	entries, err = StatusInfo(context.Background(), []string{"deps/lib"}, global.UpperC(super.Dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 || entries[0].State != StateUninitialized || entries[0].SHA != libHead {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+}
@@ -0,0 +1,130 @@
+package submodule
+
+import "github.com/kumose-go/xgit/types"
+
+// Add the given repository as a submodule at the given path (derived from the repository if empty).
+// usage: git submodule add [-b <branch>] [-f|--force] [--name <name>] [--reference <repository>] [--depth <depth>] [--] <repository> [<path>]
+func Add(repository, path string, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("add")
+		g.ApplyOptions(options...)
+		g.AddOptions("--")
+		g.AddOptions(repository)
+
+		if path != "" {
+			g.AddOptions(path)
+		}
+	}
+}
+
+// Status Show the status of the submodules (all if paths is empty).
+// usage: git submodule status [--cached] [--recursive] [--] [<path>...]
+func Status(paths []string, options ...types.Option) types.Option {
+	return subCommand("status", paths, options)
+}
+
+// Init Initialize the submodules recorded in the index (all if paths is empty).
+// usage: git submodule init [--] [<path>...]
+func Init(paths []string, options ...types.Option) types.Option {
+	return subCommand("init", paths, options)
+}
+
+// Deinit Unregister the given submodules (use All to unregister all the submodules).
+// usage: git submodule deinit [-f|--force] (--all|[--] <path>...)
+func Deinit(paths []string, options ...types.Option) types.Option {
+	return subCommand("deinit", paths, options)
+}
+
+// Update the registered submodules to match what the superproject expects (all if paths is empty).
+// usage: git submodule update [--init] [--remote] [-N|--no-fetch] [--[no-]recommend-shallow] [-f|--force] [--checkout|--rebase|--merge] [--reference <repository>] [--depth <depth>] [--recursive] [--jobs <n>] [--[no-]single-branch] [--filter <filter-spec>] [--] [<path>...]
+func Update(paths []string, options ...types.Option) types.Option {
+	return subCommand("update", paths, options)
+}
+
+// Sync the remote URL configuration of the submodules to the value specified in .gitmodules (all if paths is empty).
+// usage: git submodule sync [--recursive] [--] [<path>...]
+func Sync(paths []string, options ...types.Option) types.Option {
+	return subCommand("sync", paths, options)
+}
+
+// SetBranch Set the default remote tracking branch of a submodule (the remote HEAD if branch is empty).
+// usage: git submodule set-branch (-b|--branch) <branch> [--] <path>
+// usage: git submodule set-branch (-d|--default) [--] <path>
+func SetBranch(path, branch string, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("set-branch")
+		g.ApplyOptions(options...)
+
+		if branch == "" {
+			g.AddOptions("--default")
+		} else {
+			g.ApplyOptions(Branch(branch))
+		}
+
+		g.AddOptions("--")
+		g.AddOptions(path)
+	}
+}
+
+// SetURL Set the URL of a submodule.
+// usage: git submodule set-url [--] <path> <newurl>
+func SetURL(path, newURL string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("set-url")
+		g.AddOptions("--")
+		g.AddOptions(path)
+		g.AddOptions(newURL)
+	}
+}
+
+// Summary Show the commit summary between the given commit (defaults to HEAD) and the working tree/index.
+// usage: git submodule summary [--cached|--files] [(-n|--summary-limit) <n>] [commit] [--] [<path>...]
+func Summary(commit string, paths []string, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("summary")
+		g.ApplyOptions(options...)
+
+		if commit != "" {
+			g.AddOptions(commit)
+		}
+
+		addPaths(g, paths)
+	}
+}
+
+// Foreach Evaluate a shell command in each checked out submodule.
+// The command has access to the variables $name, $sm_path, $displaypath, $sha1 and $toplevel.
+// usage: git submodule foreach [--recursive] <command>
+func Foreach(command string, options ...types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("foreach")
+		g.ApplyOptions(options...)
+		g.AddOptions(command)
+	}
+}
+
+// AbsorbGitDirs Move the git directory of the submodules into the superproject (all if paths is empty).
+// usage: git submodule absorbgitdirs [--] [<path>...]
+func AbsorbGitDirs(paths []string, options ...types.Option) types.Option {
+	return subCommand("absorbgitdirs", paths, options)
+}
+
+func subCommand(name string, paths []string, options []types.Option) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(name)
+		g.ApplyOptions(options...)
+		addPaths(g, paths)
+	}
+}
+
+func addPaths(g *types.Cmd, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+
+	g.AddOptions("--")
+
+	for _, path := range paths {
+		g.AddOptions(path)
+	}
+}
@@ -0,0 +1,57 @@
+package gswitch
+
+import "github.com/kumose-go/xgit/types"
+
+// Branch <branch>
+// Branch to switch to.
+// Use Create, ForceCreate, Detach or Orphan to switch to a new branch or to a commit.
+func Branch(branch string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions(branch)
+	}
+}
+
+// Create Create a new branch named <new-branch> starting at <start-point> (HEAD if empty) before switching to the branch.
+// This is a convenient shortcut for:
+// $ git branch <new-branch>
+// $ git switch <new-branch>
+// -c <new-branch>, --create <new-branch> [<start-point>]
+func Create(newBranch, startPoint string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--create")
+		g.AddOptions(newBranch)
+
+		if startPoint != "" {
+			g.AddOptions(startPoint)
+		}
+	}
+}
+
+// ForceCreate Similar to Create except that if <new-branch> already exists, it will be reset to <start-point> (HEAD if empty).
+// This is a convenient shortcut for:
+// $ git branch -f <new-branch>
+// $ git switch <new-branch>
+// -C <new-branch>, --force-create <new-branch> [<start-point>]
+func ForceCreate(newBranch, startPoint string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--force-create")
+		g.AddOptions(newBranch)
+
+		if startPoint != "" {
+			g.AddOptions(startPoint)
+		}
+	}
+}
+
+// Detach Switch to a commit (HEAD if empty) for inspection and discardable experiments.
+// See the "DETACHED HEAD" section in git-checkout(1) for details.
+// -d, --detach [<start-point>]
+func Detach(commit string) types.Option {
+	return func(g *types.Cmd) {
+		g.AddOptions("--detach")
+
+		if commit != "" {
+			g.AddOptions(commit)
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package types
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"io"
 	"log"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
 )
 
@@ -17,8 +21,34 @@ type logger interface {
 }
 
 // Executor The Git command call function.
+// An executor runs the command with the standard input (Cmd.Stdin) and the environment (Cmd.Env) of the Cmd,
+// and when Cmd.Stdout is set, it writes the standard output to it and returns only the standard error.
 type Executor func(ctx context.Context, name string, debug bool, args ...string) (string, error)
 
+// ErrStdinUnsupported The executor cannot give the standard input to the command.
+var ErrStdinUnsupported = errors.New("the executor does not support the standard input")
+
+// OutputExecutor Adapts an executor that only returns the output of the command (like exec.Cmd.CombinedOutput):
+// when Cmd.Stdout is set, the output of a successful call is written to it, and the output of a failed call is the standard error.
+// The executor has no access to the standard input: the call of a command with a standard input fails with ErrStdinUnsupported.
+// The environment (Cmd.Env) is not given to the executor.
+func OutputExecutor(g *Cmd, executor Executor) Executor {
+	return func(ctx context.Context, name string, debug bool, args ...string) (string, error) {
+		if g.Stdin != nil {
+			return "", ErrStdinUnsupported
+		}
+
+		output, err := executor(ctx, name, debug, args...)
+		if g.Stdout == nil || err != nil {
+			return output, err
+		}
+
+		_, err = io.WriteString(g.Stdout, output)
+
+		return "", err
+	}
+}
+
 // Cmd Command.
 type Cmd struct {
 	Debug       bool
@@ -27,6 +57,12 @@ type Cmd struct {
 	Options     []string
 	Logger      logger
 	Executor    Executor
+	// Stdin Standard input of the command (optional).
+	Stdin io.Reader
+	// Stdout When set, the standard output is written to it and the executor returns only the standard error.
+	Stdout io.Writer
+	// Env Additional environment variables of the command (`KEY=value`).
+	Env []string
 }
 
 // NewCmd Creates a new Cmd.
@@ -45,6 +81,19 @@ func NewCmd(name string) *Cmd {
 // Option Command option.
 type Option func(g *Cmd)
 
+// GlobalOptions Returns an option that only applies the global settings of the given options
+// (base options, executor, logger, debug...): the command options are ignored.
+// Useful to run a companion command with the same settings as the main one.
+func GlobalOptions(options ...Option) Option {
+	return func(g *Cmd) {
+		cmdOptions := slices.Clone(g.Options)
+
+		g.ApplyOptions(options...)
+
+		g.Options = cmdOptions
+	}
+}
+
 // AddOptions Add one command option.
 func (g *Cmd) AddOptions(option string) {
 	g.Options = append(g.Options, option)
@@ -67,14 +116,97 @@ func (g *Cmd) Exec(ctx context.Context, name string, debug bool, args ...string)
 	return g.Executor(ctx, name, debug, args...)
 }
 
+// Output Execute the Git command call and returns only the standard output.
+// The standard error is kept in the returned Error.
+func (g *Cmd) Output(ctx context.Context) (string, error) {
+	var stdout strings.Builder
+
+	g.Stdout = &stdout
+
+	stderr, err := g.Exec(ctx, g.Base, g.Debug, slices.Concat(g.BaseOptions, g.Options)...)
+	if err != nil {
+		return stdout.String(), &Error{Err: err, Stderr: stderr}
+	}
+
+	return stdout.String(), nil
+}
+
+// Pipe Execute the Git command call in the background and returns a reader on the standard output.
+// Once the output is exhausted, the reader returns the command Error, if any.
+// Closing the reader before the end stops the command.
+func (g *Cmd) Pipe(ctx context.Context) io.ReadCloser {
+	pr, pw := io.Pipe()
+
+	g.Stdout = pw
+
+	go func() {
+		stderr, err := g.Exec(ctx, g.Base, g.Debug, slices.Concat(g.BaseOptions, g.Options)...)
+		if err != nil {
+			_ = pw.CloseWithError(&Error{Err: err, Stderr: stderr})
+			return
+		}
+
+		_ = pw.Close()
+	}()
+
+	return pr
+}
+
+// Error A failed Git command call.
+type Error struct {
+	Err    error
+	Stderr string
+}
+
+func (e *Error) Error() string {
+	msg := strings.TrimSpace(e.Stderr)
+	if msg == "" {
+		return e.Err.Error()
+	}
+
+	return e.Err.Error() + ": " + msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode Returns the exit code of a failed Git command call, or -1 if unknown.
+func ExitCode(err error) int {
+	var exitErr interface{ ExitCode() int }
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
+
 func defaultExecutor(g *Cmd) Executor {
 	return func(ctx context.Context, name string, debug bool, args ...string) (string, error) {
 		if debug {
 			g.Logger.Println(name, strings.Join(args, " "))
 		}
 
-		output, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+		cmd := exec.CommandContext(ctx, name, args...)
+		cmd.Stdin = g.Stdin
+
+		if len(g.Env) > 0 {
+			cmd.Env = append(os.Environ(), g.Env...)
+		}
+
+		if g.Stdout == nil {
+			output, err := cmd.CombinedOutput()
+
+			return string(output), err
+		}
+
+		var stderr bytes.Buffer
+
+		cmd.Stdout = g.Stdout
+		cmd.Stderr = &stderr
+
+		err := cmd.Run()
 
-		return string(output), err
+		return stderr.String(), err
 	}
 }